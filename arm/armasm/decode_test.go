@@ -52,15 +52,22 @@ func TestDecode(t *testing.T) {
 		if err != nil {
 			out = "error: " + err.Error()
 		} else {
+			var toks []Token
+			emit := func(t Token) { toks = append(toks, t) }
 			switch syntax {
 			case "gnu":
 				out = GNUSyntax(inst)
+				GNUSyntaxTokens(inst, emit)
 			case "plan9": // [sic]
 				out = GoSyntax(inst, 0, nil, nil)
+				GoSyntaxTokens(inst, 0, nil, nil, emit)
 			default:
 				t.Errorf("unknown syntax %q", syntax)
 				continue
 			}
+			if s := joinTokens(toks); s != out {
+				t.Errorf("Decode(%s) [%s] tokens = %q, want %q", f[0], syntax, s, out)
+			}
 		}
 		if out != asm || inst.Len != size {
 			t.Errorf("Decode(%s) [%s] = %s, %d, want %s, %d", f[0], syntax, out, inst.Len, asm, size)
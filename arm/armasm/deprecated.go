@@ -0,0 +1,128 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// A Deprecation identifies an A32 instruction that ARMv8 AArch32
+// removes or deprecates.
+type Deprecation uint8
+
+const (
+	_ Deprecation = iota
+
+	// DeprecatedSWP is SWP or SWPB, which ARMv8 AArch32 removes.
+	// They are UNDEFINED; use LDREX and STREX instead.
+	DeprecatedSWP
+
+	// DeprecatedCP15Barrier is an MCR to the CP15 c7 registers that
+	// ARMv6 used as ISB, DSB and DMB operations. ARMv8 deprecates
+	// them, and they are UNDEFINED when SCTLR.CP15BEN is 0.
+	// Use the ISB, DSB and DMB instructions instead.
+	DeprecatedCP15Barrier
+
+	// DeprecatedSETEND is SETEND, which ARMv8 deprecates.
+	// It is UNDEFINED when SCTLR.SED is 1. Use REV and its
+	// variants to change the byte order of data instead.
+	DeprecatedSETEND
+)
+
+var deprecationInfo = [...]struct {
+	name, replacement, control string
+}{
+	DeprecatedSWP:         {"DeprecatedSWP", "LDREX/STREX", ""},
+	DeprecatedCP15Barrier: {"DeprecatedCP15Barrier", "ISB/DSB/DMB", "SCTLR.CP15BEN"},
+	DeprecatedSETEND:      {"DeprecatedSETEND", "REV", "SCTLR.SED"},
+}
+
+func (d Deprecation) valid() bool {
+	return 0 < d && int(d) < len(deprecationInfo)
+}
+
+func (d Deprecation) String() string {
+	if d.valid() {
+		return deprecationInfo[d].name
+	}
+	return fmt.Sprintf("Deprecation(%d)", int(d))
+}
+
+// Removed reports whether ARMv8 AArch32 removes the instructions
+// entirely, rather than deprecating them.
+func (d Deprecation) Removed() bool {
+	return d == DeprecatedSWP
+}
+
+// Replacement returns the instructions to use instead.
+func (d Deprecation) Replacement() string {
+	if d.valid() {
+		return deprecationInfo[d].replacement
+	}
+	return ""
+}
+
+// Control returns the system control register field that makes
+// the instructions UNDEFINED, or "" if there is none.
+func (d Deprecation) Control() string {
+	if d.valid() {
+		return deprecationInfo[d].control
+	}
+	return ""
+}
+
+// CheckDeprecated reports whether the ARM-mode instruction at the
+// start of src is one that ARMv8 AArch32 removes or deprecates,
+// and if so, which. It works from the encoding, so it recognizes
+// CP15 barrier operations even though Decode does not decode
+// coprocessor instructions.
+func CheckDeprecated(src []byte, mode Mode) (Deprecation, bool) {
+	if mode != ModeARM || len(src) < 4 {
+		return 0, false
+	}
+	x := binary.LittleEndian.Uint32(src)
+	if x>>28 != 0xf {
+		// MCR p15, 0, <Rt>, c7, <CRm>, <opc2>, with any Rt.
+		switch x & 0x0fff0fff {
+		case 0x0e070f95, // CP15ISB: c7, c5, 4
+			0x0e070f9a, // CP15DSB: c7, c10, 4
+			0x0e070fba: // CP15DMB: c7, c10, 5
+			return DeprecatedCP15Barrier, true
+		}
+	}
+	inst, err := Decode(src, mode)
+	if err != nil {
+		return 0, false
+	}
+	switch {
+	case SWP_EQ <= inst.Op && inst.Op < SWP_EQ+32: // SWP and SWPB, all conditions
+		return DeprecatedSWP, true
+	case inst.Op == SETEND:
+		return DeprecatedSETEND, true
+	}
+	return 0, false
+}
+
+// A DeprecatedUse is an occurrence of a deprecated instruction.
+type DeprecatedUse struct {
+	PC          uint32 // address of the instruction
+	Enc         uint32 // encoding of the instruction
+	Deprecation Deprecation
+}
+
+// FindDeprecated returns the removed and deprecated instructions in
+// code, which holds ARM-mode instructions starting at address pc.
+// It examines each aligned word of code in turn.
+func FindDeprecated(code []byte, mode Mode, pc uint32) []DeprecatedUse {
+	var uses []DeprecatedUse
+	for i := 0; i+4 <= len(code); i += 4 {
+		if d, ok := CheckDeprecated(code[i:], mode); ok {
+			enc := binary.LittleEndian.Uint32(code[i:])
+			uses = append(uses, DeprecatedUse{pc + uint32(i), enc, d})
+		}
+	}
+	return uses
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func TestCheckDeprecated(t *testing.T) {
+	tests := []struct {
+		enc  uint32
+		want Deprecation
+	}{
+		{0xe1020091, DeprecatedSWP},         // swp r0, r1, [r2]
+		{0xe1420091, DeprecatedSWP},         // swpb r0, r1, [r2]
+		{0x11020091, DeprecatedSWP},         // swpne r0, r1, [r2]
+		{0xf1010200, DeprecatedSETEND},      // setend be
+		{0xee070f95, DeprecatedCP15Barrier}, // mcr p15, 0, r0, c7, c5, 4
+		{0xee073f9a, DeprecatedCP15Barrier}, // mcr p15, 0, r3, c7, c10, 4
+		{0x0e070fba, DeprecatedCP15Barrier}, // mcreq p15, 0, r0, c7, c10, 5
+		{0xee070f3a, 0},                     // mcr p15, 0, r0, c7, c10, 1
+		{0xf57ff05b, 0},                     // dmb ish
+		{0xe1900f9f, 0},                     // ldrex r0, [r0]
+		{0xe0810002, 0},                     // add r0, r1, r2
+	}
+	var src [4]byte
+	for _, tt := range tests {
+		binary.LittleEndian.PutUint32(src[:], tt.enc)
+		d, ok := CheckDeprecated(src[:], ModeARM)
+		if d != tt.want || ok != (tt.want != 0) {
+			t.Errorf("CheckDeprecated(%#08x) = %v, %v, want %v", tt.enc, d, ok, tt.want)
+		}
+	}
+	if _, ok := CheckDeprecated(src[:], ModeThumb); ok {
+		t.Errorf("CheckDeprecated in Thumb mode = true, want false")
+	}
+}
+
+func TestFindDeprecated(t *testing.T) {
+	encs := []uint32{0xe0810002, 0xe1020091, 0xee070fba, 0xf1010200, 0xe0810002}
+	code := make([]byte, 4*len(encs))
+	for i, enc := range encs {
+		binary.LittleEndian.PutUint32(code[4*i:], enc)
+	}
+	want := []DeprecatedUse{
+		{0x8004, 0xe1020091, DeprecatedSWP},
+		{0x8008, 0xee070fba, DeprecatedCP15Barrier},
+		{0x800c, 0xf1010200, DeprecatedSETEND},
+	}
+	if got := FindDeprecated(code, ModeARM, 0x8000); !reflect.DeepEqual(got, want) {
+		t.Errorf("FindDeprecated = %v, want %v", got, want)
+	}
+	if !DeprecatedSWP.Removed() || DeprecatedSETEND.Removed() {
+		t.Errorf("Removed: only DeprecatedSWP should be removed")
+	}
+	if got := DeprecatedCP15Barrier.Control(); got != "SCTLR.CP15BEN" {
+		t.Errorf("DeprecatedCP15Barrier.Control() = %q, want SCTLR.CP15BEN", got)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Disassemble writes a GNU syntax listing of the ARM-mode code at
+// addresses [start, end) to w, one instruction per line, showing
+// the address, the instruction word and the instruction, as objdump
+// does:
+//
+//	1000:	e0810002	add r0, r1, r2
+//
+// text holds code located at address base, such as an *elf.Section
+// with base set to the section's Addr. Words that do not decode are
+// shown as .word directives.
+func Disassemble(w io.Writer, text io.ReaderAt, base, start, end uint64) error {
+	code, err := readCode(text, base, start, end)
+	if err != nil {
+		return err
+	}
+	for pc := start; len(code) > 0; {
+		var line string
+		n := 4
+		if len(code) < 4 {
+			n = len(code)
+			line = fmt.Sprintf("% x\t.byte %#02x", code, code[0])
+			for _, c := range code[1:] {
+				line += fmt.Sprintf(",%#02x", c)
+			}
+		} else {
+			x := binary.LittleEndian.Uint32(code)
+			if inst, err := Decode(code, ModeARM); err == nil {
+				line = fmt.Sprintf("%08x\t%s", x, GNUSyntax(inst))
+			} else {
+				line = fmt.Sprintf("%08x\t.word %#08x\t@ undefined", x, x)
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%x:\t%s\n", pc, line); err != nil {
+			return err
+		}
+		code = code[n:]
+		pc += uint64(n)
+	}
+	return nil
+}
+
+// readCode reads the bytes at addresses [start, end)
+// from text, which holds code located at base.
+func readCode(text io.ReaderAt, base, start, end uint64) ([]byte, error) {
+	if start < base || end < start {
+		return nil, fmt.Errorf("armasm: invalid address range %#x-%#x for text at %#x", start, end, base)
+	}
+	code := make([]byte, end-start)
+	n, err := text.ReadAt(code, int64(start-base))
+	if n == len(code) {
+		err = nil
+	}
+	return code, err
+}
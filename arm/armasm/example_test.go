@@ -0,0 +1,48 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+
+	"golang.org/x/arch/arm/armasm"
+)
+
+func ExampleDecode() {
+	code := []byte{0x02, 0x00, 0x81, 0xe0} // add r0, r1, r2
+	inst, err := armasm.Decode(code, armasm.ModeARM)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(inst.Op)
+	fmt.Println(armasm.GNUSyntax(inst))
+	fmt.Println(armasm.GoSyntax(inst, 0x1000, nil, nil))
+	// Output:
+	// ADD
+	// add r0, r1, r2
+	// ADD R2, R1, R0
+}
+
+func ExampleDisassemble() {
+	// A function at address 0x1000 in a text section starting
+	// at 0x1000, as from an *elf.Section.
+	text := bytes.NewReader([]byte{
+		0x04, 0xe0, 0x2d, 0xe5, // push {lr}
+		0x02, 0x00, 0x81, 0xe0, // add r0, r1, r2
+		0xff, 0xff, 0xff, 0xff, // undefined
+		0x04, 0xf0, 0x9d, 0xe4, // pop {pc}
+	})
+	if err := armasm.Disassemble(os.Stdout, text, 0x1000, 0x1000, 0x1010); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// 1000:	e52de004	push {lr}
+	// 1004:	e0810002	add r0, r1, r2
+	// 1008:	ffffffff	.word 0xffffffff	@ undefined
+	// 100c:	e49df004	pop {pc}
+}
@@ -5,7 +5,6 @@
 package armasm
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
 )
@@ -24,40 +23,44 @@ var saveDot = strings.NewReplacer(
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This form typically matches the syntax defined in the ARM Reference Manual.
 func GNUSyntax(inst Inst) string {
-	var buf bytes.Buffer
+	return gnuTokens(inst).String()
+}
+
+func gnuTokens(inst Inst) tokens {
+	var ts tokens
 	op := inst.Op.String()
 	op = saveDot.Replace(op)
 	op = strings.Replace(op, ".", "", -1)
 	op = strings.Replace(op, "_dot_", ".", -1)
 	op = strings.ToLower(op)
-	buf.WriteString(op)
+	ts.add(TokenMnemonic, op)
 	sep := " "
 	for i, arg := range inst.Args {
 		if arg == nil {
 			break
 		}
 		text := gnuArg(&inst, i, arg)
-		if text == "" {
+		if text == nil {
 			continue
 		}
-		buf.WriteString(sep)
+		ts.text(sep)
 		sep = ", "
-		buf.WriteString(text)
+		ts.addAll(text)
 	}
-	return buf.String()
+	return ts
 }
 
-func gnuArg(inst *Inst, argIndex int, arg Arg) string {
+func gnuArg(inst *Inst, argIndex int, arg Arg) tokens {
 	switch inst.Op &^ 15 {
 	case LDRD_EQ, LDREXD_EQ, STRD_EQ:
 		if argIndex == 1 {
 			// second argument in consecutive pair not printed
-			return ""
+			return nil
 		}
 	case STREXD_EQ:
 		if argIndex == 2 {
 			// second argument in consecutive pair not printed
-			return ""
+			return nil
 		}
 	}
 
@@ -65,100 +68,90 @@ func gnuArg(inst *Inst, argIndex int, arg Arg) string {
 	case Imm:
 		switch inst.Op &^ 15 {
 		case BKPT_EQ:
-			return fmt.Sprintf("%#04x", uint32(arg))
+			return tok(TokenImmediate, fmt.Sprintf("%#04x", uint32(arg)))
 		case SVC_EQ:
-			return fmt.Sprintf("%#08x", uint32(arg))
+			return tok(TokenImmediate, fmt.Sprintf("%#08x", uint32(arg)))
 		}
-		return fmt.Sprintf("#%d", int32(arg))
+		return tok(TokenImmediate, fmt.Sprintf("#%d", int32(arg)))
 
 	case ImmAlt:
-		return fmt.Sprintf("#%d, %d", arg.Val, arg.Rot)
+		ts := tok(TokenImmediate, fmt.Sprintf("#%d", arg.Val))
+		ts.text(", ")
+		ts.add(TokenImmediate, fmt.Sprintf("%d", arg.Rot))
+		return ts
 
 	case Mem:
 		R := gnuArg(inst, -1, arg.Base)
-		X := ""
+		var X tokens
 		if arg.Sign != 0 {
-			X = ""
 			if arg.Sign < 0 {
-				X = "-"
+				X.text("-")
 			}
-			X += gnuArg(inst, -1, arg.Index)
+			X.addAll(gnuArg(inst, -1, arg.Index))
 			if arg.Shift == ShiftLeft && arg.Count == 0 {
 				// nothing
 			} else if arg.Shift == RotateRightExt {
-				X += ", rrx"
+				X.text(", rrx")
 			} else {
-				X += fmt.Sprintf(", %s #%d", strings.ToLower(arg.Shift.String()), arg.Count)
+				X.text(fmt.Sprintf(", %s ", strings.ToLower(arg.Shift.String())))
+				X.add(TokenImmediate, fmt.Sprintf("#%d", arg.Count))
 			}
 		} else {
-			X = fmt.Sprintf("#%d", arg.Offset)
-		}
-
-		switch arg.Mode {
-		case AddrOffset:
-			if X == "#0" {
-				return fmt.Sprintf("[%s]", R)
-			}
-			return fmt.Sprintf("[%s, %s]", R, X)
-		case AddrPreIndex:
-			return fmt.Sprintf("[%s, %s]!", R, X)
-		case AddrPostIndex:
-			return fmt.Sprintf("[%s], %s", R, X)
-		case AddrLDM:
-			if X == "#0" {
-				return R
-			}
-		case AddrLDM_WB:
-			if X == "#0" {
-				return R + "!"
-			}
+			X.add(TokenImmediate, fmt.Sprintf("#%d", arg.Offset))
 		}
-		return fmt.Sprintf("[%s Mode(%d) %s]", R, int(arg.Mode), X)
+		return memTokens(R, X, arg.Mode, arg.Sign == 0 && arg.Offset == 0)
 
 	case PCRel:
-		return fmt.Sprintf(".%+#x", int32(arg)+4)
+		return tok(TokenAddress, fmt.Sprintf(".%+#x", int32(arg)+4))
 
 	case Reg:
 		switch inst.Op &^ 15 {
 		case LDREX_EQ:
 			if argIndex == 0 {
-				return fmt.Sprintf("r%d", int32(arg))
+				return tok(TokenRegister, fmt.Sprintf("r%d", int32(arg)))
 			}
 		}
 		switch arg {
 		case R10:
-			return "sl"
+			return tok(TokenRegister, "sl")
 		case R11:
-			return "fp"
+			return tok(TokenRegister, "fp")
 		case R12:
-			return "ip"
+			return tok(TokenRegister, "ip")
 		}
 
 	case RegList:
-		var buf bytes.Buffer
-		fmt.Fprintf(&buf, "{")
+		ts := tok(TokenText, "{")
 		sep := ""
 		for i := 0; i < 16; i++ {
 			if arg&(1<<uint(i)) != 0 {
-				fmt.Fprintf(&buf, "%s%s", sep, gnuArg(inst, -1, Reg(i)))
+				ts.text(sep)
+				ts.addAll(gnuArg(inst, -1, Reg(i)))
 				sep = ", "
 			}
 		}
-		fmt.Fprintf(&buf, "}")
-		return buf.String()
+		ts.text("}")
+		return ts
 
 	case RegShift:
+		ts := gnuArg(inst, -1, arg.Reg)
 		if arg.Shift == ShiftLeft && arg.Count == 0 {
-			return gnuArg(inst, -1, arg.Reg)
+			return ts
 		}
 		if arg.Shift == RotateRightExt {
-			return gnuArg(inst, -1, arg.Reg) + ", rrx"
+			ts.text(", rrx")
+			return ts
 		}
-		return fmt.Sprintf("%s, %s #%d", gnuArg(inst, -1, arg.Reg), strings.ToLower(arg.Shift.String()), arg.Count)
+		ts.text(fmt.Sprintf(", %s ", strings.ToLower(arg.Shift.String())))
+		ts.add(TokenImmediate, fmt.Sprintf("#%d", arg.Count))
+		return ts
 
 	case RegShiftReg:
-		return fmt.Sprintf("%s, %s %s", gnuArg(inst, -1, arg.Reg), strings.ToLower(arg.Shift.String()), gnuArg(inst, -1, arg.RegCount))
+		ts := gnuArg(inst, -1, arg.Reg)
+		ts.text(fmt.Sprintf(", %s ", strings.ToLower(arg.Shift.String())))
+		ts.addAll(gnuArg(inst, -1, arg.RegCount))
+		return ts
 
 	}
-	return strings.ToLower(arg.String())
+	return argTokens(arg, strings.ToLower(arg.String()))
 }
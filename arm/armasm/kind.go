@@ -0,0 +1,164 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "golang.org/x/arch/internal/asmarg"
+
+// An ArgKind classifies an instruction argument. The x86asm, arm64asm
+// and ppc64asm packages share the same type and kinds.
+type ArgKind = asmarg.Kind
+
+const (
+	KindOther   = asmarg.Other   // none of the other kinds, such as an Endian
+	KindReg     = asmarg.Reg     // register, possibly shifted (Reg, RegX, RegShift, RegShiftReg)
+	KindImm     = asmarg.Imm     // integer or floating-point constant
+	KindMem     = asmarg.Mem     // memory reference
+	KindPCRel   = asmarg.PCRel   // PC-relative address
+	KindAddr    = asmarg.Addr    // absolute code address (Label)
+	KindRegList = asmarg.RegList // register list
+	KindCond    = asmarg.Cond    // condition; conditions are part of Op in this package
+	KindSysReg  = asmarg.SysReg  // status register (APSR, APSR_nzcv, FPSCR)
+)
+
+// A RegRef describes a register, as RegValue returns it.
+// It is the same type in all the disassembler packages.
+type RegRef = asmarg.RegRef
+
+// A MemRef describes a memory reference, as MemValue returns it.
+// It is the same type in all the disassembler packages.
+type MemRef = asmarg.MemRef
+
+// Kind returns KindReg or, for a status register, KindSysReg.
+func (r Reg) Kind() ArgKind {
+	if r == APSR || r == APSR_nzcv || r == FPSCR {
+		return KindSysReg
+	}
+	return KindReg
+}
+
+// Kind returns KindReg.
+func (RegX) Kind() ArgKind { return KindReg }
+
+// Kind returns KindReg.
+func (RegShift) Kind() ArgKind { return KindReg }
+
+// Kind returns KindReg.
+func (RegShiftReg) Kind() ArgKind { return KindReg }
+
+// Kind returns KindImm.
+func (Imm) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (ImmAlt) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Float32Imm) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Float64Imm) Kind() ArgKind { return KindImm }
+
+// Kind returns KindMem.
+func (Mem) Kind() ArgKind { return KindMem }
+
+// Kind returns KindPCRel.
+func (PCRel) Kind() ArgKind { return KindPCRel }
+
+// Kind returns KindAddr.
+func (Label) Kind() ArgKind { return KindAddr }
+
+// Kind returns KindRegList.
+func (RegList) Kind() ArgKind { return KindRegList }
+
+// Kind returns KindOther.
+func (Endian) Kind() ArgKind { return KindOther }
+
+// ArgKindOf returns the kind of a, as its Kind method reports it.
+// It returns KindOther for nil and for an Arg implementation
+// outside this package that has no Kind method.
+func ArgKindOf(a Arg) ArgKind {
+	if k, ok := a.(interface{ Kind() ArgKind }); ok {
+		return k.Kind()
+	}
+	return KindOther
+}
+
+// ImmValue returns the value of a, if a is an integer constant.
+// The value of an ImmAlt is that of ImmAlt.Imm.
+func ImmValue(a Arg) (int64, bool) {
+	switch a := a.(type) {
+	case Imm:
+		return int64(a), true
+	case ImmAlt:
+		return int64(a.Imm()), true
+	}
+	return 0, false
+}
+
+// RegValue returns the register a, if a is a register.
+// For a RegX, RegShift or RegShiftReg, it returns the register
+// that is indexed or shifted. The status registers have Num -1.
+func RegValue(a Arg) (RegRef, bool) {
+	var r Reg
+	switch a := a.(type) {
+	case Reg:
+		r = a
+	case RegX:
+		r = a.Reg
+	case RegShift:
+		r = a.Reg
+	case RegShiftReg:
+		r = a.Reg
+	default:
+		return RegRef{}, false
+	}
+	ref := RegRef{Name: r.String(), Num: -1}
+	switch {
+	case R0 <= r && r <= R15:
+		ref.Num = int(r - R0)
+	case S0 <= r && r <= S31:
+		ref.Num = int(r - S0)
+	case D0 <= r && r <= D31:
+		ref.Num = int(r - D0)
+	}
+	return ref, true
+}
+
+// MemValue returns the memory reference inst.Args[i], if that
+// argument is one that a MemRef can describe. A MemRef cannot
+// describe a subtracted index or one shifted other than left;
+// use the Mem fields for those. For post-indexed and LDM/STM
+// addressing, the address accessed is the base register alone.
+func MemValue(inst Inst, i int) (MemRef, bool) {
+	m, ok := inst.Args[i].(Mem)
+	if !ok {
+		return MemRef{}, false
+	}
+	ref := MemRef{Base: m.Base.String()}
+	if m.Mode != AddrOffset && m.Mode != AddrPreIndex {
+		return ref, true
+	}
+	switch {
+	case m.Sign > 0 && (m.Shift == ShiftLeft || m.Count == 0):
+		ref.Index = m.Index.String()
+		ref.Scale = 1 << m.Count
+	case m.Sign != 0:
+		return MemRef{}, false
+	default:
+		ref.Disp = int64(m.Offset)
+	}
+	return ref, true
+}
+
+// PCRelTarget returns the address that the PC-relative argument
+// of inst refers to, for ARM mode inst located at pc. The boolean
+// result reports whether inst has a PC-relative argument.
+func PCRelTarget(inst Inst, pc uint64) (uint64, bool) {
+	for _, a := range inst.Args {
+		if r, ok := a.(PCRel); ok {
+			return uint64(uint32(pc) + 8 + uint32(r)), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestArgKindOf(t *testing.T) {
+	tests := []struct {
+		enc   uint32
+		kinds string
+		imm   int64
+	}{
+		{0xe2810010, "Reg Reg Imm", 0x10},  // add r0, r1, #0x10
+		{0xe2810b01, "Reg Reg Imm", 0x400}, // add r0, r1, #0x400
+		{0xe8bd8010, "RegList", 0},         // pop {r4, pc}
+		{0xea000000, "PCRel", 0},           // b .+8
+		{0xe5910004, "Reg Mem", 0},         // ldr r0, [r1, #4]
+		{0xe0810312, "Reg Reg Reg", 0},     // add r0, r1, r2, lsl r3
+		{0xeef1fa10, "SysReg SysReg", 0},   // vmrs APSR_nzcv, fpscr
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:], ModeARM)
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		var kinds []string
+		for _, a := range inst.Args {
+			if a == nil {
+				break
+			}
+			kinds = append(kinds, ArgKindOf(a).String())
+			if v, ok := ImmValue(a); ok && v != tt.imm {
+				t.Errorf("%v: ImmValue(%v) = %d, want %d", inst, a, v, tt.imm)
+			}
+		}
+		if s := strings.Join(kinds, " "); s != tt.kinds {
+			t.Errorf("%v: kinds %q, want %q", inst, s, tt.kinds)
+		}
+	}
+}
+
+func TestArgValues(t *testing.T) {
+	tests := []struct {
+		enc uint32
+		i   int
+		reg RegRef
+		mem MemRef
+	}{
+		{0xe5910004, 0, RegRef{Name: "R0", Num: 0}, MemRef{}}, // ldr r0, [r1, #4]
+		{0xe5910004, 1, RegRef{}, MemRef{Base: "R1", Disp: 4}},
+		{0xe5110004, 1, RegRef{}, MemRef{Base: "R1", Disp: -4}},              // ldr r0, [r1, #-4]
+		{0xe5b10004, 1, RegRef{}, MemRef{Base: "R1", Disp: 4}},               // ldr r0, [r1, #4]!
+		{0xe4910004, 1, RegRef{}, MemRef{Base: "R1"}},                        // ldr r0, [r1], #4
+		{0xe7910102, 1, RegRef{}, MemRef{Base: "R1", Index: "R2", Scale: 4}}, // ldr r0, [r1, r2, lsl #2]
+		{0xe7110002, 1, RegRef{}, MemRef{}},                                  // ldr r0, [r1, -r2]
+		{0xe0810312, 2, RegRef{Name: "R2", Num: 2}, MemRef{}},                // add r0, r1, r2, lsl r3
+		{0xeef1fa10, 1, RegRef{Name: "FPSCR", Num: -1}, MemRef{}},            // vmrs APSR_nzcv, fpscr
+		{0xee311b02, 2, RegRef{Name: "D2", Num: 2}, MemRef{}},                // vadd.f64 d1, d1, d2
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:], ModeARM)
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		reg, ok := RegValue(inst.Args[tt.i])
+		if ok != (tt.reg != RegRef{}) || reg != tt.reg {
+			t.Errorf("%v: RegValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, reg, ok, tt.reg)
+		}
+		mem, ok := MemValue(inst, tt.i)
+		if ok != (tt.mem != MemRef{}) || mem != tt.mem {
+			t.Errorf("%v: MemValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, mem, ok, tt.mem)
+		}
+	}
+}
+
+func TestPCRelTarget(t *testing.T) {
+	tests := []struct {
+		enc    uint32
+		target uint64
+		ok     bool
+	}{
+		{0xea000000, 0x1008, true}, // b .+8
+		{0xebfffffe, 0x1000, true}, // bl .
+		{0xe12fff1e, 0, false},     // bx lr
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:], ModeARM)
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		if target, ok := PCRelTarget(inst, 0x1000); target != tt.target || ok != tt.ok {
+			t.Errorf("%v: PCRelTarget = %#x, %v, want %#x, %v", inst, target, ok, tt.target, tt.ok)
+		}
+	}
+}
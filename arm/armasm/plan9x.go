@@ -5,7 +5,6 @@
 package armasm
 
 import (
-	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -23,11 +22,15 @@ import (
 // The reader r should read from the text segment using text addresses
 // as offsets; it is used to display pc-relative loads as constant loads.
 func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) string {
+	return goTokens(inst, pc, symname, text).String()
+}
+
+func goTokens(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) tokens {
 	if symname == nil {
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
 
-	var args []string
+	var args []tokens
 	for _, a := range inst.Args {
 		if a == nil {
 			break
@@ -43,7 +46,10 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		reg, _ := inst.Args[0].(Reg)
 		mem, _ := inst.Args[1].(Mem)
 		if inst.Op&^15 == LDR_EQ && reg == R15 && mem.Base == SP && mem.Sign == 0 && mem.Mode == AddrPostIndex {
-			return fmt.Sprintf("RET%s #%d", op[3:], mem.Offset)
+			ts := tok(TokenMnemonic, "RET"+op[3:])
+			ts.text(" ")
+			ts.add(TokenImmediate, fmt.Sprintf("#%d", mem.Offset))
+			return ts
 		}
 
 		// Check for PC-relative load.
@@ -55,13 +61,13 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 				if _, err := text.ReadAt(buf[:1], int64(addr)); err != nil {
 					break
 				}
-				args[1] = fmt.Sprintf("$%#x", buf[0])
+				args[1] = tok(TokenImmediate, fmt.Sprintf("$%#x", buf[0]))
 
 			case LDRH_EQ, LDRSH_EQ:
 				if _, err := text.ReadAt(buf[:2], int64(addr)); err != nil {
 					break
 				}
-				args[1] = fmt.Sprintf("$%#x", binary.LittleEndian.Uint16(buf))
+				args[1] = tok(TokenImmediate, fmt.Sprintf("$%#x", binary.LittleEndian.Uint16(buf)))
 
 			case LDR_EQ:
 				if _, err := text.ReadAt(buf[:4], int64(addr)); err != nil {
@@ -69,23 +75,23 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 				}
 				x := binary.LittleEndian.Uint32(buf)
 				if s, base := symname(uint64(x)); s != "" && uint64(x) == base {
-					args[1] = fmt.Sprintf("$%s(SB)", s)
+					args[1] = tokens{{TokenText, "$"}, {TokenSymbol, s}, {TokenText, "(SB)"}}
 				} else {
-					args[1] = fmt.Sprintf("$%#x", x)
+					args[1] = tok(TokenImmediate, fmt.Sprintf("$%#x", x))
 				}
 
 			case VLDR_EQ:
 				switch {
-				case strings.HasPrefix(args[0], "D"): // VLDR.F64
+				case strings.HasPrefix(args[0].String(), "D"): // VLDR.F64
 					if _, err := text.ReadAt(buf, int64(addr)); err != nil {
 						break
 					}
-					args[1] = fmt.Sprintf("$%f", math.Float64frombits(binary.LittleEndian.Uint64(buf)))
-				case strings.HasPrefix(args[0], "S"): // VLDR.F32
+					args[1] = tok(TokenImmediate, fmt.Sprintf("$%f", math.Float64frombits(binary.LittleEndian.Uint64(buf))))
+				case strings.HasPrefix(args[0].String(), "S"): // VLDR.F32
 					if _, err := text.ReadAt(buf[:4], int64(addr)); err != nil {
 						break
 					}
-					args[1] = fmt.Sprintf("$%f", math.Float32frombits(binary.LittleEndian.Uint32(buf)))
+					args[1] = tok(TokenImmediate, fmt.Sprintf("$%f", math.Float32frombits(binary.LittleEndian.Uint32(buf))))
 				default:
 					panic(fmt.Sprintf("wrong FP register: %v", inst))
 				}
@@ -123,12 +129,12 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 	// For MLA-like instructions, the addend is the third operand.
 	switch inst.Op &^ 15 {
 	case SMLAWT_EQ, SMLAWB_EQ, MLA_EQ, MLA_S_EQ, MLS_EQ, SMMLA_EQ, SMMLS_EQ, SMLABB_EQ, SMLATB_EQ, SMLABT_EQ, SMLATT_EQ, SMLAD_EQ, SMLAD_X_EQ, SMLSD_EQ, SMLSD_X_EQ:
-		args = []string{args[1], args[2], args[0], args[3]}
+		args = []tokens{args[1], args[2], args[0], args[3]}
 	}
 	// For STREX like instructions, the memory operands comes first.
 	switch inst.Op &^ 15 {
 	case STREX_EQ, STREXB_EQ, STREXH_EQ, SWP_EQ, SWP_B_EQ:
-		args = []string{args[1], args[0], args[2]}
+		args = []tokens{args[1], args[0], args[2]}
 	}
 
 	// special process for FP instructions
@@ -169,11 +175,16 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		op = op + suffix
 	}
 
-	if args != nil {
-		op += " " + strings.Join(args, ", ")
+	ts := tok(TokenMnemonic, op)
+	for i, a := range args {
+		if i == 0 {
+			ts.text(" ")
+		} else {
+			ts.text(", ")
+		}
+		ts.addAll(a)
 	}
-
-	return op
+	return ts
 }
 
 // assembler syntax for the various shifts.
@@ -182,41 +193,58 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 // was a different operation (rotate right extended, not rotate right).
 var plan9Shift = []string{"<<", ">>", "->", "@>", "@x>"}
 
-func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) string {
+func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) tokens {
 	switch a := arg.(type) {
 	case Endian:
 
 	case Imm:
-		return fmt.Sprintf("$%d", uint32(a))
+		return tok(TokenImmediate, fmt.Sprintf("$%d", uint32(a)))
 
 	case Mem:
+		// As strings.ToUpper(a.String()).
+		R := tok(TokenRegister, a.Base.String())
+		var X tokens
+		if a.Sign != 0 {
+			if a.Sign < 0 {
+				X.text("-")
+			} else {
+				X.text("+")
+			}
+			X.add(TokenRegister, a.Index.String())
+			if a.Shift != ShiftLeft || a.Count != 0 {
+				X.text(fmt.Sprintf(", %s ", strings.ToUpper(a.Shift.String())))
+				X.add(TokenImmediate, fmt.Sprintf("#%d", a.Count))
+			}
+		} else {
+			X.add(TokenImmediate, fmt.Sprintf("#%d", a.Offset))
+		}
+		return memTokens(R, X, a.Mode, a.Sign == 0 && a.Offset == 0)
 
 	case PCRel:
 		addr := uint32(pc) + 8 + uint32(a)
 		if s, base := symname(uint64(addr)); s != "" && uint64(addr) == base {
-			return fmt.Sprintf("%s(SB)", s)
+			return tokens{{TokenSymbol, s}, {TokenText, "(SB)"}}
 		}
-		return fmt.Sprintf("%#x", addr)
+		return tok(TokenAddress, fmt.Sprintf("%#x", addr))
 
 	case Reg:
 		if a < 16 {
-			return fmt.Sprintf("R%d", int(a))
+			return tok(TokenRegister, fmt.Sprintf("R%d", int(a)))
 		}
 
 	case RegList:
-		var buf bytes.Buffer
+		ts := tok(TokenText, "[")
 		start := -2
 		end := -2
-		fmt.Fprintf(&buf, "[")
 		flush := func() {
 			if start >= 0 {
-				if buf.Len() > 1 {
-					fmt.Fprintf(&buf, ",")
+				if len(ts) > 1 {
+					ts.text(",")
 				}
-				if start == end {
-					fmt.Fprintf(&buf, "R%d", start)
-				} else {
-					fmt.Fprintf(&buf, "R%d-R%d", start, end)
+				ts.add(TokenRegister, fmt.Sprintf("R%d", start))
+				if start != end {
+					ts.text("-")
+					ts.add(TokenRegister, fmt.Sprintf("R%d", end))
 				}
 				start = -2
 				end = -2
@@ -235,16 +263,22 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 			}
 		}
 		flush()
-		fmt.Fprintf(&buf, "]")
-		return buf.String()
+		ts.text("]")
+		return ts
 
 	case RegShift:
-		return fmt.Sprintf("R%d%s$%d", int(a.Reg), plan9Shift[a.Shift], int(a.Count))
+		ts := tok(TokenRegister, fmt.Sprintf("R%d", int(a.Reg)))
+		ts.text(plan9Shift[a.Shift])
+		ts.add(TokenImmediate, fmt.Sprintf("$%d", int(a.Count)))
+		return ts
 
 	case RegShiftReg:
-		return fmt.Sprintf("R%d%sR%d", int(a.Reg), plan9Shift[a.Shift], int(a.RegCount))
+		ts := tok(TokenRegister, fmt.Sprintf("R%d", int(a.Reg)))
+		ts.text(plan9Shift[a.Shift])
+		ts.add(TokenRegister, fmt.Sprintf("R%d", int(a.RegCount)))
+		return ts
 	}
-	return strings.ToUpper(arg.String())
+	return argTokens(arg, strings.ToUpper(arg.String()))
 }
 
 // convert memory operand from GNU syntax to Plan 9 syntax, for example,
@@ -260,7 +294,7 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 //
 //	corresponding memory operand in Plan 9 syntax
 //	.W/.P/.U suffix
-func memOpTrans(mem Mem) (string, string) {
+func memOpTrans(mem Mem) (tokens, string) {
 	suffix := ""
 	switch mem.Mode {
 	case AddrOffset, AddrLDM:
@@ -270,24 +304,26 @@ func memOpTrans(mem Mem) (string, string) {
 	case AddrPostIndex:
 		suffix = ".P"
 	}
-	off := ""
+	var ts tokens
 	if mem.Offset != 0 {
-		off = fmt.Sprintf("%#x", mem.Offset)
+		ts.add(TokenImmediate, fmt.Sprintf("%#x", mem.Offset))
 	}
-	base := fmt.Sprintf("(R%d)", int(mem.Base))
-	index := ""
+	ts.text("(")
+	ts.add(TokenRegister, fmt.Sprintf("R%d", int(mem.Base)))
+	ts.text(")")
 	if mem.Sign != 0 {
-		sign := ""
 		if mem.Sign < 0 {
 			suffix += ".U"
 		}
-		shift := ""
+		ts.text("(")
+		ts.add(TokenRegister, fmt.Sprintf("R%d", int(mem.Index)))
 		if mem.Count != 0 {
-			shift = fmt.Sprintf("%s%d", plan9Shift[mem.Shift], mem.Count)
+			ts.text(plan9Shift[mem.Shift])
+			ts.add(TokenImmediate, fmt.Sprintf("%d", mem.Count))
 		}
-		index = fmt.Sprintf("(%sR%d%s)", sign, int(mem.Index), shift)
+		ts.text(")")
 	}
-	return off + base + index, suffix
+	return ts, suffix
 }
 
 type goFPInfo struct {
@@ -350,7 +386,7 @@ var fpInst []goFPInfo = []goFPInfo{
 // vldr s2, [r11] -> MOVF (R11), F1
 // inputs: instruction name and arguments in GNU syntax
 // return values: corresponding instruction name and arguments in Plan 9 syntax
-func fpTrans(inst *Inst, op string, args []string) (string, []string) {
+func fpTrans(inst *Inst, op string, args []tokens) (string, []tokens) {
 	for _, fp := range fpInst {
 		if inst.Op&^15 == fp.op {
 			// remove gnu syntax suffixes
@@ -362,9 +398,9 @@ func fpTrans(inst *Inst, op string, args []string) (string, []string) {
 			// compose op name
 			if fp.op == VLDR_EQ || fp.op == VSTR_EQ {
 				switch {
-				case strings.HasPrefix(args[fp.transArgs[0]], "D"):
+				case strings.HasPrefix(args[fp.transArgs[0]].String(), "D"):
 					op = "MOVD" + op[len(fp.gnuName):]
-				case strings.HasPrefix(args[fp.transArgs[0]], "S"):
+				case strings.HasPrefix(args[fp.transArgs[0]].String(), "S"):
 					op = "MOVF" + op[len(fp.gnuName):]
 				default:
 					panic(fmt.Sprintf("wrong FP register: %v", inst))
@@ -374,21 +410,22 @@ func fpTrans(inst *Inst, op string, args []string) (string, []string) {
 			}
 			// transform registers
 			for ix, ri := range fp.transArgs {
+				arg := args[ri].String()
 				switch {
-				case strings.HasSuffix(args[ri], "[1]"): // MOVW Rx, Dy[1]
+				case strings.HasSuffix(arg, "[1]"): // MOVW Rx, Dy[1]
 					break
-				case strings.HasSuffix(args[ri], "[0]"): // Dx[0] -> Fx
-					args[ri] = strings.Replace(args[ri], "[0]", "", -1)
+				case strings.HasSuffix(arg, "[0]"): // Dx[0] -> Fx
+					arg = strings.Replace(arg, "[0]", "", -1)
 					fallthrough
-				case strings.HasPrefix(args[ri], "D"): // Dx -> Fx
-					args[ri] = "F" + args[ri][1:]
-				case strings.HasPrefix(args[ri], "S"):
+				case strings.HasPrefix(arg, "D"): // Dx -> Fx
+					args[ri] = tok(TokenRegister, "F"+arg[1:])
+				case strings.HasPrefix(arg, "S"):
 					if inst.Args[ix].(Reg)&1 == 0 { // Sx -> Fy, y = x/2, if x is even
-						args[ri] = fmt.Sprintf("F%d", (inst.Args[ix].(Reg)-S0)/2)
+						args[ri] = tok(TokenRegister, fmt.Sprintf("F%d", (inst.Args[ix].(Reg)-S0)/2))
 					}
-				case strings.HasPrefix(args[ri], "$"): // CMPF/CMPD $0, Fx
+				case strings.HasPrefix(arg, "$"): // CMPF/CMPD $0, Fx
 					break
-				case strings.HasPrefix(args[ri], "R"): // MOVW Rx, Dy[1]
+				case strings.HasPrefix(arg, "R"): // MOVW Rx, Dy[1]
 					break
 				default:
 					panic(fmt.Sprintf("wrong FP register: %v", inst))
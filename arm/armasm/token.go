@@ -0,0 +1,181 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A TokenKind describes the role of a Token in formatted assembly text.
+type TokenKind uint8
+
+const (
+	TokenText      TokenKind = iota // punctuation, spacing and other literal text
+	TokenMnemonic                   // instruction mnemonic, including any suffixes
+	TokenRegister                   // register or status register
+	TokenImmediate                  // immediate constant or memory displacement
+	TokenAddress                    // code address, such as a branch target
+	TokenSymbol                     // symbol name
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case TokenText:
+		return "Text"
+	case TokenMnemonic:
+		return "Mnemonic"
+	case TokenRegister:
+		return "Register"
+	case TokenImmediate:
+		return "Immediate"
+	case TokenAddress:
+		return "Address"
+	case TokenSymbol:
+		return "Symbol"
+	}
+	return fmt.Sprintf("TokenKind(%d)", int(k))
+}
+
+// A Token is a piece of formatted assembly text.
+// Concatenating the Text of all the tokens emitted for an instruction
+// yields exactly the string returned by the corresponding syntax function.
+type Token struct {
+	Kind TokenKind
+	Text string
+}
+
+// GNUSyntaxTokens is like GNUSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func GNUSyntaxTokens(inst Inst, emit func(Token)) {
+	gnuTokens(inst).emit(emit)
+}
+
+// GoSyntaxTokens is like GoSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func GoSyntaxTokens(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt, emit func(Token)) {
+	goTokens(inst, pc, symname, text).emit(emit)
+}
+
+// A tokens is formatted assembly text, as a sequence of tokens.
+// The syntax functions format instructions as tokens and return
+// their concatenation, so the token streams follow the printers
+// exactly.
+type tokens []Token
+
+// add appends s as a token of the given kind.
+// Adjacent text tokens are merged.
+func (ts *tokens) add(kind TokenKind, s string) {
+	if s == "" {
+		return
+	}
+	if n := len(*ts); kind == TokenText && n > 0 && (*ts)[n-1].Kind == TokenText {
+		(*ts)[n-1].Text += s
+		return
+	}
+	*ts = append(*ts, Token{kind, s})
+}
+
+func (ts *tokens) text(s string) {
+	ts.add(TokenText, s)
+}
+
+func (ts *tokens) addAll(us tokens) {
+	for _, t := range us {
+		ts.add(t.Kind, t.Text)
+	}
+}
+
+func (ts tokens) String() string {
+	var b strings.Builder
+	for _, t := range ts {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+func (ts tokens) emit(emit func(Token)) {
+	for _, t := range ts {
+		emit(t)
+	}
+}
+
+// tok returns a single token of the given kind.
+func tok(kind TokenKind, s string) tokens {
+	return tokens{{kind, s}}
+}
+
+// argTokens returns the tokens for s, the formatted form of arg,
+// for argument types that the printers format as a whole.
+func argTokens(arg Arg, s string) tokens {
+	switch arg.(type) {
+	case Reg:
+		return tok(TokenRegister, s)
+	case RegX:
+		var ts tokens
+		if i := strings.IndexByte(s, '['); i > 0 && strings.HasSuffix(s, "]") {
+			ts.add(TokenRegister, s[:i])
+			ts.text("[")
+			ts.add(TokenImmediate, s[i+1:len(s)-1])
+			ts.text("]")
+			return ts
+		}
+		return tok(TokenRegister, s)
+	case Imm, ImmAlt, Float32Imm, Float64Imm:
+		return tok(TokenImmediate, s)
+	case Label, PCRel:
+		return tok(TokenAddress, s)
+	}
+	return tok(TokenText, s)
+}
+
+// memTokens returns the tokens of a memory argument with base register
+// R, index or offset X and addressing mode mode, in the bracketed form
+// that GNUSyntax and Mem.String share. The zero flag reports whether X
+// is a zero offset, which is then left out.
+func memTokens(R, X tokens, mode AddrMode, zero bool) tokens {
+	var ts tokens
+	switch mode {
+	case AddrOffset:
+		ts.text("[")
+		ts.addAll(R)
+		if !zero {
+			ts.text(", ")
+			ts.addAll(X)
+		}
+		ts.text("]")
+		return ts
+	case AddrPreIndex:
+		ts.text("[")
+		ts.addAll(R)
+		ts.text(", ")
+		ts.addAll(X)
+		ts.text("]!")
+		return ts
+	case AddrPostIndex:
+		ts.text("[")
+		ts.addAll(R)
+		ts.text("], ")
+		ts.addAll(X)
+		return ts
+	case AddrLDM:
+		if zero {
+			return R
+		}
+	case AddrLDM_WB:
+		if zero {
+			ts.addAll(R)
+			ts.text("!")
+			return ts
+		}
+	}
+	ts.text("[")
+	ts.addAll(R)
+	ts.text(fmt.Sprintf(" Mode(%d) ", int(mode)))
+	ts.addAll(X)
+	ts.text("]")
+	return ts
+}
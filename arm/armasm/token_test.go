@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func joinTokens(toks []Token) string {
+	var b strings.Builder
+	for _, t := range toks {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+func TestSyntaxTokens(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		if addr == 0x1000 {
+			return "main.(*T).m", 0x1000
+		}
+		return "", 0
+	}
+	var tests = []struct {
+		enc    string
+		syntax string
+		want   []Token
+	}{
+		{"0793eab0", "gnu", []Token{
+			{TokenMnemonic, "rsclt"}, {TokenText, " "}, {TokenRegister, "r9"}, {TokenText, ", "},
+			{TokenRegister, "sl"}, {TokenText, ", "}, {TokenRegister, "r7"}, {TokenText, ", lsl "},
+			{TokenImmediate, "#6"},
+		}},
+		{"21c2eb8b", "gnu", []Token{
+			{TokenMnemonic, "blhi"}, {TokenText, " "}, {TokenAddress, ".-0x50f778"},
+		}},
+		{"ed003be9", "plan9", []Token{
+			{TokenMnemonic, "LDMDB"}, {TokenText, " ["}, {TokenRegister, "R0"}, {TokenText, ","},
+			{TokenRegister, "R2"}, {TokenText, "-"}, {TokenRegister, "R3"}, {TokenText, ","},
+			{TokenRegister, "R5"}, {TokenText, "-"}, {TokenRegister, "R7"}, {TokenText, "], "},
+			{TokenRegister, "R11"}, {TokenText, "!"},
+		}},
+		{"feffffeb", "plan9", []Token{
+			{TokenMnemonic, "BL"}, {TokenText, " "}, {TokenSymbol, "main.(*T).m"}, {TokenText, "(SB)"},
+		}},
+	}
+	for _, tt := range tests {
+		code, err := hex.DecodeString(tt.enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := Decode(code, ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%s): %v", tt.enc, err)
+			continue
+		}
+		var toks []Token
+		emit := func(t Token) { toks = append(toks, t) }
+		if tt.syntax == "gnu" {
+			GNUSyntaxTokens(inst, emit)
+		} else {
+			GoSyntaxTokens(inst, 0x1000, symname, nil, emit)
+		}
+		if !reflect.DeepEqual(toks, tt.want) {
+			t.Errorf("%s [%s] tokens = %v, want %v", tt.enc, tt.syntax, toks, tt.want)
+		}
+	}
+}
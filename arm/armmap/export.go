@@ -0,0 +1,108 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// The json and csv output formats describe the instruction table in
+// a form meant for programs not written in Go and for comparing the
+// tables of different releases. They are the same formats written by
+// x86map and ppc64map.
+//
+// The json format is an array with one object per instruction form,
+// with these fields:
+//
+//	op - opcode name, without the condition suffix
+//	syntax - assembly syntax from the manual
+//	encoding - encoding from the manual
+//	mask, value - the form matches an instruction word w when w&mask == value,
+//		as hexadecimal strings
+//	operands - operand names from the manual, in syntax order
+//	extension - ISA extension that introduced the instruction, if any
+//	privileged - whether the instruction can only be executed
+//		by the operating system or hypervisor
+//
+// The csv format has a header line naming the same fields, in the same
+// order, followed by one line per instruction form. Operands are
+// separated by semicolons.
+//
+// The table in arm.csv describes only instructions available
+// in User mode, so none are marked privileged.
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// An exportInst is one instruction form in the json and csv formats.
+type exportInst struct {
+	Op         string   `json:"op"`
+	Syntax     string   `json:"syntax"`
+	Encoding   string   `json:"encoding"`
+	Mask       string   `json:"mask,omitempty"`
+	Value      string   `json:"value,omitempty"`
+	Operands   []string `json:"operands"`
+	Extension  string   `json:"extension,omitempty"`
+	Privileged bool     `json:"privileged"`
+}
+
+// printExport implements the -fmt=json and -fmt=csv modes.
+func printExport(p *Prog) {
+	var insts []exportInst
+	for _, inst := range p.Inst {
+		// add records a second, lower-priority copy of forms
+		// with 'should be' bits, matching them loosely.
+		// Export only the exact form.
+		if inst.Priority%2 != 0 {
+			continue
+		}
+		e := exportInst{
+			Op:       strings.Replace(inst.OpBase, ".EQ", "", 1),
+			Syntax:   inst.Text,
+			Encoding: inst.Encoding,
+			Mask:     fmt.Sprintf("%#08x", inst.Mask),
+			Value:    fmt.Sprintf("%#08x", inst.Value),
+			Operands: []string{},
+		}
+		for _, a := range inst.Args {
+			// Each arg is the syntax followed by the fields encoding it.
+			if i := strings.Index(a, "|"); i >= 0 {
+				a = a[:i]
+			}
+			e.Operands = append(e.Operands, a)
+		}
+		if strings.HasPrefix(inst.Text, "V") {
+			e.Extension = "VFP"
+		}
+		insts = append(insts, e)
+	}
+	if err := writeExport(os.Stdout, *format, insts); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeExport writes insts to w in the given format, json or csv.
+func writeExport(w io.Writer, format string, insts []exportInst) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "\t")
+		return enc.Encode(insts)
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"op", "syntax", "encoding", "mask", "value", "operands", "extension", "privileged"})
+		for _, inst := range insts {
+			cw.Write([]string{inst.Op, inst.Syntax, inst.Encoding, inst.Mask, inst.Value, strings.Join(inst.Operands, ";"), inst.Extension, strconv.FormatBool(inst.Privileged)})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
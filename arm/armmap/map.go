@@ -12,6 +12,10 @@
 //
 //	text (default) - print decoding tree in text form
 //	decoder - print decoding tables for the armasm package
+//	json - print the instruction table as JSON, for use outside Go
+//	csv - print the instruction table as CSV, for use outside Go
+//
+// The json and csv formats are described in export.go.
 package main
 
 import (
@@ -26,7 +30,7 @@ import (
 	"strings"
 )
 
-var format = flag.String("fmt", "text", "output format: text, decoder")
+var format = flag.String("fmt", "text", "output format: text, decoder, json, csv")
 
 var inputFile string
 
@@ -55,6 +59,8 @@ func main() {
 		print = printText
 	case "decoder":
 		print = printDecoder
+	case "json", "csv":
+		print = printExport
 	}
 
 	p, err := readCSV(flag.Arg(0))
@@ -31,19 +31,27 @@ var (
 	errUnknown = fmt.Errorf("unknown instruction")
 )
 
+// decoderCover records coverage information for which instruction
+// formats have been matched. It is nil except during testing.
 var decoderCover []bool
 
-func init() {
-	decoderCover = make([]bool, len(instFormats))
-}
-
 // Decode decodes the 4 bytes in src as a single instruction.
+// The bytes are in little-endian order, as A64 instructions are
+// stored in memory even in big-endian images; use a Decoder to
+// decode words stored in the other order.
 func Decode(src []byte) (inst Inst, err error) {
+	return decode(src, binary.LittleEndian, nil)
+}
+
+// decode is the implementation of Decode.
+// It reads the instruction word from src using ord.
+// If ac is not nil, decode uses it to avoid allocating argument values.
+func decode(src []byte, ord binary.ByteOrder, ac *argCache) (inst Inst, err error) {
 	if len(src) < 4 {
 		return Inst{}, errShort
 	}
 
-	x := binary.LittleEndian.Uint32(src)
+	x := ord.Uint32(src)
 
 Search:
 	for i := range instFormats {
@@ -60,18 +68,25 @@ Search:
 			if aop == 0 {
 				break
 			}
-			arg := decodeArg(aop, x)
+			arg := ac.decodeArg(aop, x)
 			if arg == nil { // Cannot decode argument
 				continue Search
 			}
 			args[j] = arg
 		}
-		decoderCover[i] = true
+		if decoderCover != nil {
+			decoderCover[i] = true
+		}
 		inst = Inst{
 			Op:   f.op,
 			Args: args,
 			Enc:  x,
 		}
+		if f.op == HINT {
+			if op, ok := extHint(uint8(args[0].(Imm_hint))); ok {
+				inst = Inst{Op: op, Enc: x}
+			}
+		}
 		return inst, nil
 	}
 	return Inst{}, errUnknown
@@ -2676,6 +2691,7 @@ func handle_ExtendedRegister(x uint32, has_width bool) Arg {
 	case 7:
 		rea.extShift = sxtx
 	}
+	rea.raw = uxtb + ExtShift(option)
 	rea.show_zero = false
 	rea.amount = uint8(imm3)
 	return rea
@@ -2702,6 +2718,7 @@ func handle_ImmediateShiftedRegister(x uint32, max uint8, is_w, has_ror bool) Ar
 			return nil
 		}
 	}
+	rsa.raw = rsa.extShift
 	rsa.show_zero = true
 	rsa.amount = uint8((x >> 10) & (1<<6 - 1))
 	if rsa.amount == 0 && rsa.extShift == lsl {
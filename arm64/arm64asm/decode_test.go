@@ -51,15 +51,22 @@ func testDecode(t *testing.T, syntax string) {
 			continue
 		}
 		var out string
+		var toks []Token
+		emit := func(t Token) { toks = append(toks, t) }
 		switch syntax {
 		case "gnu":
 			out = GNUSyntax(inst)
+			GNUSyntaxTokens(inst, emit)
 		case "plan9":
 			out = GoSyntax(inst, 0, nil, nil)
+			GoSyntaxTokens(inst, 0, nil, nil, emit)
 		default:
 			t.Errorf("unknown syntax %q", syntax)
 			continue
 		}
+		if s := joinTokens(toks); s != out {
+			t.Errorf("Decode(%s) [%s] tokens = %q, want %q", strings.Trim(f[0], "|"), syntax, s, out)
+		}
 		// TODO: system instruction.
 		var Todo = strings.Fields(`
 			sys
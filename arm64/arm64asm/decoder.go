@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"sync/atomic"
+)
+
+// A Decoder decodes instructions, reusing memory across calls.
+// It remembers the arguments of recently decoded instructions so that
+// decoding a long stream of instructions does not allocate a new copy
+// of each memory operand, shifted immediate and so on: in steady state,
+// Decode allocates only for instruction words not seen recently.
+// AppendGNUSyntax can then format the instructions into a reused
+// buffer, also without allocating.
+//
+// The zero Decoder is ready to use. A Decoder is safe for concurrent
+// use by multiple goroutines, provided its fields are not changed
+// while it is in use.
+type Decoder struct {
+	// ByteOrder is the order of the bytes of each instruction word
+	// in src. If nil, it is binary.LittleEndian.
+	//
+	// A64 instructions are little-endian in memory even when data
+	// accesses are big-endian, so code read from an executable needs
+	// no ByteOrder, big-endian or not. ByteOrder is for instruction
+	// words that have been stored as big-endian data, such as those
+	// in some core dumps, trace buffers and JIT code caches.
+	ByteOrder binary.ByteOrder
+
+	// IDRegs, if non-nil, describes the processor that will run
+	// the code. Decode then returns ErrUnsupported for instructions
+	// that the processor does not implement.
+	IDRegs *IDRegs
+
+	args argCache
+}
+
+// Decode decodes the 4 bytes in src as a single instruction,
+// storing the result in *inst. It is otherwise like the package-level
+// Decode function.
+func (d *Decoder) Decode(src []byte, inst *Inst) error {
+	ord := d.ByteOrder
+	if ord == nil {
+		ord = binary.LittleEndian
+	}
+	var err error
+	*inst, err = decode(src, ord, &d.args)
+	if err == nil && d.IDRegs != nil && !d.IDRegs.implements(inst) {
+		err = ErrUnsupported
+	}
+	return err
+}
+
+// argCacheBits is the log2 of the number of entries in an argCache.
+const argCacheBits = 10
+
+// An argKey identifies a decoded argument: decodeArg is a function
+// of the argument kind and the instruction bits alone.
+type argKey struct {
+	aop instArg
+	x   uint32
+}
+
+// An argEntry is a cached argument and its key.
+type argEntry struct {
+	key argKey
+	arg Arg
+}
+
+// An argCache holds the interface values of recently decoded arguments,
+// which would otherwise be allocated again on every use.
+// It is direct-mapped: each key has a single slot, and a new
+// argument replaces whatever was in its slot. The slots are atomic,
+// so that goroutines sharing a Decoder can share its cache.
+// A nil *argCache is valid and caches nothing.
+type argCache struct {
+	slots [1 << argCacheBits]atomic.Value // *argEntry
+}
+
+// decodeArg is like the function decodeArg but consults the cache first.
+func (c *argCache) decodeArg(aop instArg, x uint32) Arg {
+	if c == nil {
+		return decodeArg(aop, x)
+	}
+	k := argKey{aop, x}
+	h := ((x ^ uint32(aop)*0x9e3779b9) * 0x9e3779b1) >> (32 - argCacheBits)
+	if e, _ := c.slots[h].Load().(*argEntry); e != nil && e.key == k {
+		return e.arg
+	}
+	a := decodeArg(aop, x)
+	if a != nil {
+		c.slots[h].Store(&argEntry{k, a})
+	}
+	return a
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+var decoderTests = [][]byte{
+	{0x20, 0x08, 0x40, 0xf9}, // ldr x0, [x1,#16]
+	{0x20, 0x0c, 0x02, 0x8b}, // add x0, x1, x2, lsl #3
+	{0x10, 0x00, 0x00, 0x94}, // bl .+0x40
+	{0xfd, 0x7b, 0xbf, 0xa9}, // stp x29, x30, [sp,#-16]!
+	{0x00, 0x04, 0xa1, 0x4e}, // add v0.4s, v0.4s, v1.4s
+	{0x00, 0x00, 0x00, 0x00}, // udf (unknown)
+}
+
+func TestDecoder(t *testing.T) {
+	var d Decoder
+	var inst Inst
+	for _, enc := range decoderTests {
+		want, wantErr := Decode(enc)
+		for i := 0; i < 2; i++ { // once to fill the cache, once to use it
+			err := d.Decode(enc, &inst)
+			if err != wantErr || !reflect.DeepEqual(inst, want) {
+				t.Errorf("Decoder.Decode(% x) = %v, %v, want %v, %v", enc, inst, err, want, wantErr)
+			}
+		}
+	}
+}
+
+func TestDecoderByteOrder(t *testing.T) {
+	d := Decoder{ByteOrder: binary.BigEndian}
+	var inst Inst
+	for _, enc := range decoderTests {
+		want, wantErr := Decode(enc)
+		swapped := []byte{enc[3], enc[2], enc[1], enc[0]}
+		err := d.Decode(swapped, &inst)
+		if err != wantErr || !reflect.DeepEqual(inst, want) {
+			t.Errorf("big-endian Decoder.Decode(% x) = %v, %v, want %v, %v", swapped, inst, err, want, wantErr)
+		}
+	}
+}
+
+func TestDecoderConcurrent(t *testing.T) {
+	// Goroutines sharing a Decoder replace each other's cache
+	// entries but must still decode like Decode.
+	r := rand.New(rand.NewSource(1))
+	encs := make([][]byte, 1000)
+	for i := range encs {
+		encs[i] = make([]byte, 4)
+		r.Read(encs[i])
+	}
+	var d Decoder
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			var inst Inst
+			for i := range encs {
+				enc := encs[(i+g*250)%len(encs)]
+				want, wantErr := Decode(enc)
+				if err := d.Decode(enc, &inst); err != wantErr || !reflect.DeepEqual(inst, want) {
+					t.Errorf("Decoder.Decode(% x) = %v, %v, want %v, %v", enc, inst, err, want, wantErr)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestDecoderAllocs(t *testing.T) {
+	var d Decoder
+	var inst Inst
+	for _, enc := range decoderTests {
+		d.Decode(enc, &inst)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, enc := range decoderTests {
+			d.Decode(enc, &inst)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Decoder.Decode allocates %v times per run, want 0", allocs)
+	}
+}
+
+func TestDecoderSyntaxAllocs(t *testing.T) {
+	var d Decoder
+	var inst Inst
+	var buf []byte
+	run := func() {
+		for _, enc := range decoderTests {
+			if d.Decode(enc, &inst) == nil {
+				buf = AppendGNUSyntax(buf[:0], inst)
+			}
+		}
+	}
+	run()
+	if allocs := testing.AllocsPerRun(100, run); allocs != 0 {
+		t.Errorf("Decoder.Decode and AppendGNUSyntax allocate %v times per run, want 0", allocs)
+	}
+}
+
+func TestAppendGNUSyntax(t *testing.T) {
+	buf := []byte("prefix: ")
+	for _, enc := range decoderTests {
+		inst, err := Decode(enc)
+		if err != nil {
+			continue
+		}
+		want := "prefix: " + GNUSyntax(inst)
+		if got := AppendGNUSyntax(buf, inst); string(got) != want {
+			t.Errorf("AppendGNUSyntax(% x) = %q, want %q", enc, got, want)
+		}
+	}
+}
+
+func BenchmarkDecoder(b *testing.B) {
+	var d Decoder
+	var inst Inst
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, enc := range decoderTests {
+			d.Decode(enc, &inst)
+		}
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, enc := range decoderTests {
+			Decode(enc)
+		}
+	}
+}
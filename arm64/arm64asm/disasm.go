@@ -0,0 +1,54 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Disassemble writes a GNU syntax listing of the code at addresses
+// [start, end) to w, one instruction per line, showing the address,
+// the instruction word and the instruction, as objdump does:
+//
+//	1000:	8b020020	add x0, x1, x2
+//
+// text holds code located at address base, such as an *elf.Section
+// with base set to the section's Addr. Words that do not decode are
+// shown as .inst directives; see GNUSyntaxFallback.
+func Disassemble(w io.Writer, text io.ReaderAt, base, start, end uint64) error {
+	code, err := readCode(text, base, start, end)
+	if err != nil {
+		return err
+	}
+	for pc := start; len(code) > 0; {
+		asm, n := GNUSyntaxFallback(code)
+		word := fmt.Sprintf("% x", code[:n])
+		if n == 4 {
+			word = fmt.Sprintf("%08x", binary.LittleEndian.Uint32(code))
+		}
+		if _, err := fmt.Fprintf(w, "%x:\t%s\t%s\n", pc, word, asm); err != nil {
+			return err
+		}
+		code = code[n:]
+		pc += uint64(n)
+	}
+	return nil
+}
+
+// readCode reads the bytes at addresses [start, end)
+// from text, which holds code located at base.
+func readCode(text io.ReaderAt, base, start, end uint64) ([]byte, error) {
+	if start < base || end < start {
+		return nil, fmt.Errorf("arm64asm: invalid address range %#x-%#x for text at %#x", start, end, base)
+	}
+	code := make([]byte, end-start)
+	n, err := text.ReadAt(code, int64(start-base))
+	if n == len(code) {
+		err = nil
+	}
+	return code, err
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+
+	"golang.org/x/arch/arm64/arm64asm"
+)
+
+func ExampleDecode() {
+	code := []byte{0x20, 0x00, 0x02, 0x8b} // add x0, x1, x2
+	inst, err := arm64asm.Decode(code)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(inst.Op)
+	fmt.Println(arm64asm.GNUSyntax(inst))
+	fmt.Println(arm64asm.GoSyntax(inst, 0x1000, nil, nil))
+	// Output:
+	// ADD
+	// add x0, x1, x2
+	// ADD R2, R1, R0
+}
+
+func ExampleDisassemble() {
+	// A function at address 0x1000 in a text section starting
+	// at 0x1000, as from an *elf.Section.
+	text := bytes.NewReader([]byte{
+		0xfd, 0x7b, 0xbf, 0xa9, // stp x29, x30, [sp,#-16]!
+		0x20, 0x00, 0x02, 0x8b, // add x0, x1, x2
+		0xff, 0xff, 0xff, 0xff, // undefined
+		0xfd, 0x7b, 0xc1, 0xa8, // ldp x29, x30, [sp],#16
+		0xc0, 0x03, 0x5f, 0xd6, // ret
+	})
+	if err := arm64asm.Disassemble(os.Stdout, text, 0x1000, 0x1000, 0x1014); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// 1000:	a9bf7bfd	stp x29, x30, [sp,#-16]!
+	// 1004:	8b020020	add x0, x1, x2
+	// 1008:	ffffffff	.inst 0xffffffff // undefined
+	// 100c:	a8c17bfd	ldp x29, x30, [sp],#16
+	// 1010:	d65f03c0	ret
+}
@@ -121,6 +121,11 @@ func testExtDis(
 	generate func(f func([]byte)),
 	allowedMismatch func(text string, inst *Inst, dec ExtInst) bool,
 ) {
+	decoderCover = make([]bool, len(instFormats))
+	defer func() {
+		decoderCover = nil
+	}()
+
 	start := time.Now()
 	ext := &ExtDis{
 		Dec:  make(chan ExtInst),
@@ -0,0 +1,111 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"fmt"
+	"sync"
+)
+
+// The architecture reserves parts of the encoding space for software
+// and implementation use: HINT immediates without an assigned meaning
+// execute as NOP, and system registers with op0 == 3 and CRn == 11 or 15
+// are IMPLEMENTATION DEFINED. JIT compilers and CPU vendors give some
+// of these encodings meanings of their own. The functions below let
+// clients name them, so that Decode and the syntax functions show them
+// symbolically instead of as HINT #imm or S3_op1_Cn_Cm_op2.
+
+var (
+	extMu      sync.RWMutex
+	extOpNames []string             // names of Ops allocated by RegisterOp, from len(opstr)
+	extHints   map[uint8]Op         // HINT immediates registered by RegisterHint
+	extSysregs map[Systemreg]string // system registers named by RegisterSystemReg
+)
+
+// RegisterOp allocates a new Op with the given name, for use with
+// RegisterHint. The name is the Op's String form; GNU syntax shows it
+// in lower case and Go syntax shows it as is.
+func RegisterOp(name string) Op {
+	extMu.Lock()
+	defer extMu.Unlock()
+	extOpNames = append(extOpNames, name)
+	return Op(len(opstr) + len(extOpNames) - 1)
+}
+
+// extOpName returns the name of an Op allocated by RegisterOp,
+// or "" if op was not allocated that way.
+func extOpName(op Op) string {
+	extMu.RLock()
+	defer extMu.RUnlock()
+	if int(op) < len(opstr) || int(op)-len(opstr) >= len(extOpNames) {
+		return ""
+	}
+	return extOpNames[int(op)-len(opstr)]
+}
+
+// RegisterHint arranges for HINT #imm to decode as an instruction
+// with opcode op and no arguments. It applies only to immediates
+// that would otherwise decode as HINT: those with an architected
+// meaning, such as YIELD or BTI, keep it. RegisterHint returns an
+// error if imm is not between 0 and 127 or was already registered.
+// It is intended to be called during initialization.
+func RegisterHint(imm uint8, op Op) error {
+	if imm > 127 {
+		return fmt.Errorf("arm64asm: HINT immediate %d out of range", imm)
+	}
+	extMu.Lock()
+	defer extMu.Unlock()
+	if _, ok := extHints[imm]; ok {
+		return fmt.Errorf("arm64asm: HINT #%d already registered", imm)
+	}
+	if extHints == nil {
+		extHints = make(map[uint8]Op)
+	}
+	extHints[imm] = op
+	return nil
+}
+
+// RegisterSystemReg gives a name to the IMPLEMENTATION DEFINED
+// system register S3_<op1>_C<crn>_C<crm>_<op2>. GNU syntax then shows
+// the register, as an argument of MRS, MSR, SYS and similar
+// instructions, using the lower-cased name. Go syntax continues to
+// show the encoded register number, which is what the Go assembler
+// accepts. RegisterSystemReg returns an error if crn is not 11 or 15,
+// if a field is out of range, or if the register was already named.
+// It is intended to be called during initialization.
+func RegisterSystemReg(name string, op1, crn, crm, op2 uint8) error {
+	if crn != 11 && crn != 15 {
+		return fmt.Errorf("arm64asm: system register C%d is not IMPLEMENTATION DEFINED", crn)
+	}
+	if op1 > 7 || crm > 15 || op2 > 7 {
+		return fmt.Errorf("arm64asm: invalid system register S3_%d_C%d_C%d_%d", op1, crn, crm, op2)
+	}
+	r := Systemreg{3, op1, crn, crm, op2}
+	extMu.Lock()
+	defer extMu.Unlock()
+	if old, ok := extSysregs[r]; ok {
+		return fmt.Errorf("arm64asm: system register %s already named %s", r.encName(), old)
+	}
+	if extSysregs == nil {
+		extSysregs = make(map[Systemreg]string)
+	}
+	extSysregs[r] = name
+	return nil
+}
+
+// extHint returns the Op registered for HINT #imm, if any.
+func extHint(imm uint8) (Op, bool) {
+	extMu.RLock()
+	defer extMu.RUnlock()
+	op, ok := extHints[imm]
+	return op, ok
+}
+
+// extSysregName returns the name registered for r, or "" if there is none.
+func extSysregName(r Systemreg) string {
+	extMu.RLock()
+	defer extMu.RUnlock()
+	return extSysregs[r]
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+var jitMarker = func() Op {
+	op := RegisterOp("JITMARK")
+	if err := RegisterHint(127, op); err != nil {
+		panic(err)
+	}
+	if err := RegisterSystemReg("IMP_TESTREG_EL1", 7, 15, 15, 7); err != nil {
+		panic(err)
+	}
+	return op
+}()
+
+func TestExtension(t *testing.T) {
+	tests := []struct {
+		enc       uint32
+		op        Op
+		gnu, plan string
+	}{
+		{0xd5032fff, jitMarker, "jitmark", "JITMARK"},
+		{0xd503201f | 126<<5, HINT, "hint #0x7e", "HINT $126"},
+		{0xd503203f, YIELD, "yield", "YIELD"},
+		{0xd53fffe0, MRS, "mrs x0, imp_testreg_el1", "MRS $32767, R0"},
+		{0xd53ffee0, MRS, "mrs x0, s3_7_c15_c14_7", "MRS $32759, R0"},
+	}
+	for _, tt := range tests {
+		src := make([]byte, 4)
+		binary.LittleEndian.PutUint32(src, tt.enc)
+		inst, err := Decode(src)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if inst.Op != tt.op {
+			t.Errorf("Decode(%#08x).Op = %v, want %v", tt.enc, inst.Op, tt.op)
+		}
+		// GNUSyntax leaves a trailing space after instructions without arguments.
+		if s := strings.TrimSpace(GNUSyntax(inst)); s != tt.gnu {
+			t.Errorf("GNUSyntax(%#08x) = %q, want %q", tt.enc, s, tt.gnu)
+		}
+		if s := GoSyntax(inst, 0, nil, nil); s != tt.plan {
+			t.Errorf("GoSyntax(%#08x) = %q, want %q", tt.enc, s, tt.plan)
+		}
+	}
+
+	if err := RegisterHint(127, jitMarker); err == nil {
+		t.Errorf("RegisterHint: duplicate registration succeeded")
+	}
+	if err := RegisterHint(128, jitMarker); err == nil {
+		t.Errorf("RegisterHint: out of range immediate accepted")
+	}
+	if err := RegisterSystemReg("MIDR", 0, 0, 0, 0); err == nil {
+		t.Errorf("RegisterSystemReg: architected register accepted")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// GNUSyntaxFallback disassembles the instruction word at the start
+// of src and returns its GNU syntax and length. Unlike GNUSyntax, it
+// always produces output that the GNU assembler accepts: a word that
+// does not decode becomes an .inst directive, such as
+//
+//	.inst 0x00000000 // undefined
+//
+// objdump writes the comment as "; undefined", but ';' separates
+// statements in AArch64 assembly, so that form does not reassemble.
+// Fewer than 4 bytes at the end of src become a .byte directive.
+// It returns "", 0 only if src is empty.
+func GNUSyntaxFallback(src []byte) (string, int) {
+	if len(src) < 4 {
+		return byteDirective(src), len(src)
+	}
+	inst, err := Decode(src)
+	if err != nil {
+		return fmt.Sprintf(".inst %#08x // undefined", binary.LittleEndian.Uint32(src)), 4
+	}
+	return GNUSyntax(inst), 4
+}
+
+// GNUDataSyntax returns the GNU syntax for the data word at the start
+// of src, for words in text sections that mapping symbols ($d) or other
+// knowledge mark as data rather than code:
+//
+//	.word 0x12345678
+//
+// As with GNUSyntaxFallback, fewer than 4 bytes become a .byte directive.
+func GNUDataSyntax(src []byte) (string, int) {
+	if len(src) < 4 {
+		return byteDirective(src), len(src)
+	}
+	return fmt.Sprintf(".word %#08x", binary.LittleEndian.Uint32(src)), 4
+}
+
+func byteDirective(src []byte) string {
+	if len(src) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(".byte ")
+	for i, c := range src {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, "%#02x", c)
+	}
+	return b.String()
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "testing"
+
+func TestGNUSyntaxFallback(t *testing.T) {
+	tests := []struct {
+		src  []byte
+		text string
+		data string
+		n    int
+	}{
+		{[]byte{0x20, 0x00, 0x02, 0x8b}, "add x0, x1, x2", ".word 0x8b020020", 4},
+		{[]byte{0x00, 0x00, 0x00, 0x00}, ".inst 0x00000000 // undefined", ".word 0x00000000", 4},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff}, ".inst 0xffffffff // undefined", ".word 0xffffffff", 4},
+		{[]byte{0x01, 0x02}, ".byte 0x01,0x02", ".byte 0x01,0x02", 2},
+		{nil, "", "", 0},
+	}
+	for _, tt := range tests {
+		if text, n := GNUSyntaxFallback(tt.src); text != tt.text || n != tt.n {
+			t.Errorf("GNUSyntaxFallback(% x) = %q, %d, want %q, %d", tt.src, text, n, tt.text, tt.n)
+		}
+		if text, n := GNUDataSyntax(tt.src); text != tt.data || n != tt.n {
+			t.Errorf("GNUDataSyntax(% x) = %q, %d, want %q, %d", tt.src, text, n, tt.data, tt.n)
+		}
+	}
+}
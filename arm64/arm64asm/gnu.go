@@ -11,25 +11,85 @@ import (
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This form typically matches the syntax defined in the ARM Reference Manual.
 func GNUSyntax(inst Inst) string {
+	return string(AppendGNUSyntax(nil, inst))
+}
+
+// AppendGNUSyntax appends the GNU assembler syntax for the instruction,
+// as GNUSyntax returns it, to dst and returns the extended buffer.
+// Together with a Decoder, it lets a disassembler format a stream of
+// instructions into a reused buffer without allocating.
+func AppendGNUSyntax(dst []byte, inst Inst) []byte {
+	return appendGNUSyntax(dst, inst, nil)
+}
+
+// appendGNUSyntax appends the GNU syntax for inst to dst and,
+// if ts is not nil, marks its tokens in ts.
+func appendGNUSyntax(dst []byte, inst Inst, ts *tokens) []byte {
+	start := len(dst)
 	switch inst.Op {
 	case RET:
 		if r, ok := inst.Args[0].(Reg); ok && r == X30 {
-			return "ret"
+			return appendTok(dst, ts, TokenMnemonic, "ret", -1)
 		}
 	case B:
-		if _, ok := inst.Args[0].(Cond); ok {
-			return strings.ToLower("b." + inst.Args[0].String() + " " + inst.Args[1].String())
+		if c, ok := inst.Args[0].(Cond); ok {
+			dst = append(dst, "b."...)
+			dst = append(dst, c.String()...)
+			ts.mark(TokenMnemonic, dst, start, -1)
+			dst = appendTok(dst, ts, TokenText, " ", -1)
+			dst = appendArg(dst, inst.Args[1], ts, 1)
+			return toLower(dst, start, ts)
 		}
-	case SYSL:
-		result := strings.ToLower(inst.String())
-		return strings.Replace(result, "c", "C", -1)
+	}
+	dst = inst.appendTo(dst, ts)
+	switch inst.Op {
 	case DCPS1, DCPS2, DCPS3, CLREX:
-		return strings.ToLower(strings.TrimSpace(inst.String()))
+		for len(dst) > start && dst[len(dst)-1] == ' ' {
+			dst = dst[:len(dst)-1]
+			ts.trim(1)
+		}
 	case ISB:
-		if strings.Contains(inst.String(), "SY") {
-			result := strings.TrimSuffix(inst.String(), " SY")
-			return strings.ToLower(result)
+		if n := len(dst) - 3; n >= start && string(dst[n:]) == " SY" {
+			dst = dst[:n]
+			ts.trim(3)
+		}
+	}
+	dst = toLower(dst, start, ts)
+	if inst.Op == SYSL {
+		// Leave the C of the Cn and Cm operands upper case.
+		for i := start; i < len(dst); i++ {
+			if dst[i] == 'c' {
+				dst[i] = 'C'
+			}
+		}
+		ts.mapText(func(s string) string { return strings.Replace(s, "c", "C", -1) })
+	}
+	return dst
+}
+
+// toLower converts the ASCII letters in b[start:], and the text of
+// the tokens in ts, to lower case, as strings.ToLower does for the
+// text of an instruction.
+func toLower(b []byte, start int, ts *tokens) []byte {
+	for i := start; i < len(b); i++ {
+		if c := b[i]; 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	ts.mapText(strings.ToLower)
+	return b
+}
+
+// GNUSyntaxVerbatim is like GNUSyntax but shows shift and extend
+// operators as encoded, including LSL #0 and UXTW or UXTX next to the
+// stack pointer, which GNUSyntax omits or shows as LSL; see
+// RegExtshiftAmount.Verbatim. It is meant for comparing encodings
+// byte for byte rather than for reading.
+func GNUSyntaxVerbatim(inst Inst) string {
+	for i, a := range inst.Args {
+		if rea, ok := a.(RegExtshiftAmount); ok {
+			inst.Args[i] = rea.Verbatim()
 		}
 	}
-	return strings.ToLower(inst.String())
+	return GNUSyntax(inst)
 }
@@ -0,0 +1,110 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "errors"
+
+// ErrUnsupported is returned by a Decoder configured with IDRegs
+// for an instruction that the described processor does not implement.
+// Such an instruction is UNDEFINED on that processor.
+// The Decoder still returns the decoded instruction along with the error.
+var ErrUnsupported = errors.New("instruction not implemented by processor")
+
+// IDRegs holds the values of the AArch64 ID registers that describe
+// which optional instructions a processor implements, as read by
+// MRS or from /sys/devices/system/cpu/cpu*/regs/identification on
+// Linux. As in the Linux kernel, a feature is implemented when its
+// 4-bit field is at least the value that introduced it, except that
+// the FP and AdvSIMD fields of PFR0 are signed and 0xF means absent.
+//
+// The Decoder consults these fields:
+//
+//	ISAR0.AES      AESD, AESE, AESIMC, AESMC; PMULL and PMULL2 on 64-bit lanes
+//	ISAR0.SHA1     SHA1C, SHA1H, SHA1M, SHA1P, SHA1SU0, SHA1SU1
+//	ISAR0.SHA2     SHA256H, SHA256H2, SHA256SU0, SHA256SU1
+//	ISAR0.CRC32    CRC32B through CRC32CX
+//	ISAR1.DPB      DC CVAP and DC CVADP
+//	PFR0.FP        scalar floating-point instructions and SIMD&FP loads and stores
+//	PFR0.AdvSIMD   Advanced SIMD instructions
+//	PFR1.MTE       DC operations on allocation tags
+//
+// No instruction the package decodes depends on ISAR2 at present;
+// it is accepted so that a complete dump can be passed as is.
+type IDRegs struct {
+	ISAR0 uint64 // ID_AA64ISAR0_EL1
+	ISAR1 uint64 // ID_AA64ISAR1_EL1
+	ISAR2 uint64 // ID_AA64ISAR2_EL1
+	PFR0  uint64 // ID_AA64PFR0_EL1
+	PFR1  uint64 // ID_AA64PFR1_EL1
+}
+
+// idField returns the 4-bit ID register field of reg at shift.
+func idField(reg uint64, shift uint) uint64 {
+	return reg >> shift & 0xF
+}
+
+// implements reports whether a processor described by r implements inst.
+func (r *IDRegs) implements(inst *Inst) bool {
+	switch inst.Op {
+	case AESD, AESE, AESIMC, AESMC:
+		return idField(r.ISAR0, 4) >= 1
+	case PMULL, PMULL2:
+		if a, ok := inst.Args[0].(RegisterWithArrangement); ok && a.a == Arrangement1Q {
+			return idField(r.ISAR0, 4) >= 2
+		}
+	case SHA1C, SHA1H, SHA1M, SHA1P, SHA1SU0, SHA1SU1:
+		return idField(r.ISAR0, 8) >= 1
+	case SHA256H, SHA256H2, SHA256SU0, SHA256SU1:
+		return idField(r.ISAR0, 12) >= 1
+	case CRC32B, CRC32H, CRC32W, CRC32X, CRC32CB, CRC32CH, CRC32CW, CRC32CX:
+		return idField(r.ISAR0, 16) >= 1
+	case DC:
+		if s, ok := inst.Args[0].(sysOp); ok {
+			return r.implementsDC(s.op.String())
+		}
+	}
+
+	x := inst.Enc
+	switch {
+	case x>>25&7 == 7:
+		// Data processing, SIMD and floating point.
+		// Scalar floating-point instructions have bit 30 clear
+		// and bit 28 set; the rest are Advanced SIMD.
+		if x>>30&1 == 0 && x>>28&1 == 1 {
+			return idField(r.PFR0, 16) != 0xF
+		}
+		return idField(r.PFR0, 20) != 0xF
+	case x>>25&5 == 4 && x>>26&1 == 1:
+		// Loads and stores of SIMD&FP registers.
+		// Those of multiple or single structures, such as LD1,
+		// are Advanced SIMD.
+		if x>>31 == 0 && x>>24&0x3E == 0x0C {
+			return idField(r.PFR0, 20) != 0xF
+		}
+		return idField(r.PFR0, 16) != 0xF
+	}
+	return true
+}
+
+// implementsDC reports whether a processor described by r
+// implements the DC operation with the given name.
+func (r *IDRegs) implementsDC(name string) bool {
+	switch name {
+	case "CVAP":
+		return idField(r.ISAR1, 0) >= 1
+	case "CVADP":
+		return idField(r.ISAR1, 0) >= 2
+	case "GVA", "GZVA":
+		return idField(r.PFR1, 8) >= 1
+	case "IGVAC", "IGSW", "IGDVAC", "IGDSW", "CGSW", "CGDSW", "CIGSW", "CIGDSW",
+		"CGVAC", "CGDVAC", "CIGVAC", "CIGDVAC":
+		return idField(r.PFR1, 8) >= 2
+	case "CGVAP", "CGDVAP":
+		return idField(r.PFR1, 8) >= 2 && idField(r.ISAR1, 0) >= 1
+	case "CGVADP", "CGDVADP":
+		return idField(r.PFR1, 8) >= 2 && idField(r.ISAR1, 0) >= 2
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestDecoderIDRegs(t *testing.T) {
+	// A processor with FP and Advanced SIMD, AES without PMULL,
+	// and CRC32 and DC CVAP, but no SHA, DC CVADP or MTE.
+	regs := IDRegs{
+		ISAR0: 1<<4 | 1<<16,
+		ISAR1: 1,
+		PFR0:  0, // FP and AdvSIMD fields 0: implemented
+	}
+	noFP := IDRegs{PFR0: 0xF<<16 | 0xF<<20}
+
+	tests := []struct {
+		regs IDRegs
+		enc  uint32
+		want bool // implemented
+	}{
+		{regs, 0x8b020020, true},  // add x0, x1, x2
+		{regs, 0x4e284820, true},  // aese v0.16b, v1.16b
+		{regs, 0x0e22e020, true},  // pmull v0.8h, v1.8b, v2.8b
+		{regs, 0x0ee2e020, false}, // pmull v0.1q, v1.1d, v2.1d
+		{regs, 0x5e280820, false}, // sha1h s0, s1
+		{regs, 0x1ac24020, true},  // crc32b w0, w1, w2
+		{regs, 0xd50b7c20, true},  // dc cvap, x0
+		{regs, 0xd50b7d20, false}, // dc cvadp, x0
+		{regs, 0xd50b7460, false}, // dc gva, x0
+		{regs, 0xd50b7b20, true},  // dc cvau, x0
+		{regs, 0x1e622820, true},  // fadd d0, d1, d2
+		{noFP, 0x1e622820, false}, // fadd d0, d1, d2
+		{noFP, 0x4ea10400, false}, // add v0.4s, v0.4s, v1.4s
+		{noFP, 0x3dc00020, false}, // ldr q0, [x1]
+		{noFP, 0x4c407020, false}, // ld1 {v0.16b}, [x1]
+		{noFP, 0xf9400820, true},  // ldr x0, [x1,#16]
+	}
+	var src [4]byte
+	for _, tt := range tests {
+		binary.LittleEndian.PutUint32(src[:], tt.enc)
+		want, err := Decode(src[:])
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		d := Decoder{IDRegs: &tt.regs}
+		var inst Inst
+		err = d.Decode(src[:], &inst)
+		if inst != want {
+			t.Errorf("Decoder.Decode(%#08x) = %v, want %v", tt.enc, inst, want)
+		}
+		if got := err == nil; got != tt.want || err != nil && err != ErrUnsupported {
+			t.Errorf("Decoder.Decode(%#08x) (%v): err = %v, want implemented=%v", tt.enc, inst, err, tt.want)
+		}
+	}
+}
@@ -6,7 +6,7 @@ package arm64asm
 
 import (
 	"fmt"
-	"strings"
+	"strconv"
 )
 
 // An Op is an ARM64 opcode.
@@ -19,6 +19,9 @@ type Op uint16
 
 func (op Op) String() string {
 	if op >= Op(len(opstr)) || opstr[op] == "" {
+		if name := extOpName(op); name != "" {
+			return name
+		}
 		return fmt.Sprintf("Op(%d)", int(op))
 	}
 	return opstr[op]
@@ -32,14 +35,72 @@ type Inst struct {
 }
 
 func (i Inst) String() string {
-	var args []string
-	for _, arg := range i.Args {
+	return string(i.appendTo(nil, nil))
+}
+
+// appendTo appends the String form of i to dst and,
+// if ts is not nil, marks its tokens in ts.
+func (i Inst) appendTo(dst []byte, ts *tokens) []byte {
+	dst = appendTok(dst, ts, TokenMnemonic, i.Op.String(), -1)
+	dst = appendTok(dst, ts, TokenText, " ", -1)
+	for j, arg := range i.Args {
 		if arg == nil {
 			break
 		}
-		args = append(args, arg.String())
+		if j > 0 {
+			dst = appendTok(dst, ts, TokenText, ", ", -1)
+		}
+		dst = appendArg(dst, arg, ts, j)
+	}
+	return dst
+}
+
+// appendArg appends the String form of a, argument i of its
+// instruction, to dst and, if ts is not nil, marks its tokens in ts.
+// For the argument types of common instructions it does so without
+// allocating.
+func appendArg(dst []byte, a Arg, ts *tokens, i int) []byte {
+	start := len(dst)
+	kind := TokenText
+	switch a := a.(type) {
+	case Reg:
+		dst, kind = a.appendTo(dst), TokenRegister
+	case RegSP:
+		dst, kind = a.appendTo(dst), TokenRegister
+	case PCRel:
+		dst, kind = a.appendTo(dst), TokenAddress
+	case Imm:
+		dst, kind = a.appendTo(dst), TokenImmediate
+	case Imm64:
+		dst, kind = a.appendTo(dst), TokenImmediate
+	case ImmShift:
+		return a.appendTo(dst, ts, i)
+	case RegExtshiftAmount:
+		return a.appendTo(dst, ts, i)
+	case MemImmediate:
+		return a.appendTo(dst, ts, i)
+	case MemExtend:
+		return a.appendTo(dst, ts, i)
+	case RegisterWithArrangement:
+		return a.appendTo(dst, ts, i)
+	case RegisterWithArrangementAndIndex:
+		return a.appendTo(dst, ts, i)
+	case sysOp:
+		return a.appendTo(dst, ts, i)
+	case Systemreg, Pstatefield:
+		dst, kind = append(dst, a.String()...), TokenRegister
+	case Imm_hint, Imm_clrex, Imm_dcps, Imm_fp:
+		dst, kind = append(dst, a.String()...), TokenImmediate
+	case Imm_option, Imm_prfop:
+		dst = append(dst, a.String()...)
+		if len(dst) > start && dst[start] == '#' {
+			kind = TokenImmediate
+		}
+	default:
+		dst = append(dst, a.String()...)
 	}
-	return i.Op.String() + " " + strings.Join(args, ", ")
+	ts.mark(kind, dst, start, i)
+	return dst
 }
 
 // An Args holds the instruction arguments.
@@ -333,31 +394,36 @@ const (
 func (Reg) isArg() {}
 
 func (r Reg) String() string {
+	return string(r.appendTo(nil))
+}
+
+func (r Reg) appendTo(dst []byte) []byte {
 	switch {
 	case r == WZR:
-		return "WZR"
+		return append(dst, "WZR"...)
 	case r == XZR:
-		return "XZR"
+		return append(dst, "XZR"...)
 	case W0 <= r && r <= W30:
-		return fmt.Sprintf("W%d", int(r-W0))
+		return strconv.AppendInt(append(dst, 'W'), int64(r-W0), 10)
 	case X0 <= r && r <= X30:
-		return fmt.Sprintf("X%d", int(r-X0))
+		return strconv.AppendInt(append(dst, 'X'), int64(r-X0), 10)
 
 	case B0 <= r && r <= B31:
-		return fmt.Sprintf("B%d", int(r-B0))
+		return strconv.AppendInt(append(dst, 'B'), int64(r-B0), 10)
 	case H0 <= r && r <= H31:
-		return fmt.Sprintf("H%d", int(r-H0))
+		return strconv.AppendInt(append(dst, 'H'), int64(r-H0), 10)
 	case S0 <= r && r <= S31:
-		return fmt.Sprintf("S%d", int(r-S0))
+		return strconv.AppendInt(append(dst, 'S'), int64(r-S0), 10)
 	case D0 <= r && r <= D31:
-		return fmt.Sprintf("D%d", int(r-D0))
+		return strconv.AppendInt(append(dst, 'D'), int64(r-D0), 10)
 	case Q0 <= r && r <= Q31:
-		return fmt.Sprintf("Q%d", int(r-Q0))
+		return strconv.AppendInt(append(dst, 'Q'), int64(r-Q0), 10)
 
 	case V0 <= r && r <= V31:
-		return fmt.Sprintf("V%d", int(r-V0))
+		return strconv.AppendInt(append(dst, 'V'), int64(r-V0), 10)
 	default:
-		return fmt.Sprintf("Reg(%d)", int(r))
+		dst = strconv.AppendInt(append(dst, "Reg("...), int64(r), 10)
+		return append(dst, ')')
 	}
 }
 
@@ -367,13 +433,17 @@ type RegSP Reg
 func (RegSP) isArg() {}
 
 func (r RegSP) String() string {
+	return string(r.appendTo(nil))
+}
+
+func (r RegSP) appendTo(dst []byte) []byte {
 	switch Reg(r) {
 	case WSP:
-		return "WSP"
+		return append(dst, "WSP"...)
 	case SP:
-		return "SP"
+		return append(dst, "SP"...)
 	default:
-		return Reg(r).String()
+		return Reg(r).appendTo(dst)
 	}
 }
 
@@ -385,13 +455,30 @@ type ImmShift struct {
 func (ImmShift) isArg() {}
 
 func (is ImmShift) String() string {
+	return string(is.appendTo(nil, nil, -1))
+}
+
+func (is ImmShift) appendTo(dst []byte, ts *tokens, arg int) []byte {
+	start := len(dst)
+	dst = appendHexImm(dst, uint64(is.imm))
+	ts.mark(TokenImmediate, dst, start, arg)
 	if is.shift == 0 {
-		return fmt.Sprintf("#%#x", is.imm)
+		return dst
 	}
-	if is.shift < 128 {
-		return fmt.Sprintf("#%#x, LSL #%d", is.imm, is.shift)
+	op, shift := ", LSL ", is.shift
+	if shift >= 128 {
+		op, shift = ", MSL ", shift-128
 	}
-	return fmt.Sprintf("#%#x, MSL #%d", is.imm, is.shift-128)
+	dst = appendTok(dst, ts, TokenText, op, arg)
+	start = len(dst)
+	dst = strconv.AppendUint(append(dst, '#'), uint64(shift), 10)
+	ts.mark(TokenImmediate, dst, start, arg)
+	return dst
+}
+
+// appendHexImm appends x in the #%#x format of package fmt to dst.
+func appendHexImm(dst []byte, x uint64) []byte {
+	return strconv.AppendUint(append(dst, "#0x"...), x, 16)
 }
 
 type ExtShift uint8
@@ -458,23 +545,48 @@ type RegExtshiftAmount struct {
 	extShift  ExtShift
 	amount    uint8
 	show_zero bool
+	raw       ExtShift // extShift as encoded, before canonicalization
 }
 
 func (RegExtshiftAmount) isArg() {}
 
+// Verbatim returns rea with the shift or extend operator and amount
+// as encoded, undoing the choices of the preferred disassembly: an
+// LSL #0 shift is omitted, and UXTW or UXTX next to the stack pointer
+// is shown as LSL, or omitted if the amount is 0.
+func (rea RegExtshiftAmount) Verbatim() RegExtshiftAmount {
+	rea.extShift = rea.raw
+	rea.show_zero = true
+	return rea
+}
+
+// Canonicalized reports whether rea shows a different shift or
+// extend operator than the encoded one; see Verbatim.
+func (rea RegExtshiftAmount) Canonicalized() bool {
+	return rea.extShift != rea.raw
+}
+
 func (rea RegExtshiftAmount) String() string {
-	buf := rea.reg.String()
+	return string(rea.appendTo(nil, nil, -1))
+}
+
+func (rea RegExtshiftAmount) appendTo(dst []byte, ts *tokens, arg int) []byte {
+	start := len(dst)
+	dst = rea.reg.appendTo(dst)
+	ts.mark(TokenRegister, dst, start, arg)
 	if rea.extShift != ExtShift(0) {
-		buf += ", " + rea.extShift.String()
-		if rea.amount != 0 {
-			buf += fmt.Sprintf(" #%d", rea.amount)
-		} else {
-			if rea.show_zero == true {
-				buf += fmt.Sprintf(" #%d", rea.amount)
-			}
+		start = len(dst)
+		dst = append(dst, ", "...)
+		dst = append(dst, rea.extShift.String()...)
+		ts.mark(TokenText, dst, start, arg)
+		if rea.amount != 0 || rea.show_zero {
+			dst = appendTok(dst, ts, TokenText, " ", arg)
+			start = len(dst)
+			dst = strconv.AppendUint(append(dst, '#'), uint64(rea.amount), 10)
+			ts.mark(TokenImmediate, dst, start, arg)
 		}
 	}
-	return buf
+	return dst
 }
 
 // A PCRel describes a memory address (usually a code label)
@@ -484,7 +596,11 @@ type PCRel int64
 func (PCRel) isArg() {}
 
 func (r PCRel) String() string {
-	return fmt.Sprintf(".%+#x", uint64(r))
+	return string(r.appendTo(nil))
+}
+
+func (r PCRel) appendTo(dst []byte) []byte {
+	return strconv.AppendUint(append(dst, ".+0x"...), uint64(r), 16)
 }
 
 // An AddrMode is an ARM addressing mode.
@@ -509,25 +625,43 @@ type MemImmediate struct {
 func (MemImmediate) isArg() {}
 
 func (m MemImmediate) String() string {
-	R := m.Base.String()
-	X := fmt.Sprintf("#%d", m.imm)
+	return string(m.appendTo(nil, nil, -1))
+}
 
+func (m MemImmediate) appendTo(dst []byte, ts *tokens, arg int) []byte {
+	switch m.Mode {
+	case AddrOffset, AddrPreIndex, AddrPostIndex, AddrPostReg:
+	default:
+		return appendTok(dst, ts, TokenText, "unimplemented!", arg)
+	}
+	dst = appendTok(dst, ts, TokenText, "[", arg)
+	start := len(dst)
+	dst = m.Base.appendTo(dst)
+	ts.mark(TokenRegister, dst, start, arg)
+	imm := func(dst []byte) []byte {
+		start := len(dst)
+		dst = strconv.AppendInt(append(dst, '#'), int64(m.imm), 10)
+		ts.mark(TokenImmediate, dst, start, arg)
+		return dst
+	}
 	switch m.Mode {
 	case AddrOffset:
-		if X == "#0" {
-			return fmt.Sprintf("[%s]", R)
+		if m.imm != 0 {
+			dst = imm(appendTok(dst, ts, TokenText, ",", arg))
 		}
-		return fmt.Sprintf("[%s,%s]", R, X)
+		return appendTok(dst, ts, TokenText, "]", arg)
 	case AddrPreIndex:
-		return fmt.Sprintf("[%s,%s]!", R, X)
+		dst = imm(appendTok(dst, ts, TokenText, ",", arg))
+		return appendTok(dst, ts, TokenText, "]!", arg)
 	case AddrPostIndex:
-		return fmt.Sprintf("[%s],%s", R, X)
-	case AddrPostReg:
-		post := Reg(X0) + Reg(m.imm)
-		postR := post.String()
-		return fmt.Sprintf("[%s], %s", R, postR)
+		return imm(appendTok(dst, ts, TokenText, "],", arg))
+	default: // AddrPostReg
+		dst = appendTok(dst, ts, TokenText, "], ", arg)
+		start = len(dst)
+		dst = (Reg(X0) + Reg(m.imm)).appendTo(dst)
+		ts.mark(TokenRegister, dst, start, arg)
+		return dst
 	}
-	return fmt.Sprintf("unimplemented!")
 }
 
 // A MemExtend is a memory reference made up of a base R and index expression X.
@@ -550,29 +684,35 @@ type MemExtend struct {
 func (MemExtend) isArg() {}
 
 func (m MemExtend) String() string {
-	Rbase := m.Base.String()
-	RIndex := m.Index.String()
-	if m.ShiftMustBeZero {
-		if m.Amount != 0 {
-			return fmt.Sprintf("[%s,%s,%s #0]", Rbase, RIndex, m.Extend.String())
-		} else {
-			if m.Extend != lsl {
-				return fmt.Sprintf("[%s,%s,%s]", Rbase, RIndex, m.Extend.String())
-			} else {
-				return fmt.Sprintf("[%s,%s]", Rbase, RIndex)
-			}
-		}
-	} else {
-		if m.Amount != 0 {
-			return fmt.Sprintf("[%s,%s,%s #%d]", Rbase, RIndex, m.Extend.String(), m.Amount)
+	return string(m.appendTo(nil, nil, -1))
+}
+
+func (m MemExtend) appendTo(dst []byte, ts *tokens, arg int) []byte {
+	dst = appendTok(dst, ts, TokenText, "[", arg)
+	start := len(dst)
+	dst = m.Base.appendTo(dst)
+	ts.mark(TokenRegister, dst, start, arg)
+	dst = appendTok(dst, ts, TokenText, ",", arg)
+	start = len(dst)
+	dst = m.Index.appendTo(dst)
+	ts.mark(TokenRegister, dst, start, arg)
+	if m.Amount != 0 || m.Extend != lsl {
+		start = len(dst)
+		dst = append(dst, ',')
+		dst = append(dst, m.Extend.String()...)
+		ts.mark(TokenText, dst, start, arg)
+	}
+	if m.Amount != 0 {
+		dst = appendTok(dst, ts, TokenText, " ", arg)
+		start = len(dst)
+		if m.ShiftMustBeZero {
+			dst = append(dst, "#0"...)
 		} else {
-			if m.Extend != lsl {
-				return fmt.Sprintf("[%s,%s,%s]", Rbase, RIndex, m.Extend.String())
-			} else {
-				return fmt.Sprintf("[%s,%s]", Rbase, RIndex)
-			}
+			dst = strconv.AppendUint(append(dst, '#'), uint64(m.Amount), 10)
 		}
+		ts.mark(TokenImmediate, dst, start, arg)
 	}
+	return appendTok(dst, ts, TokenText, "]", arg)
 }
 
 // An Imm is an integer constant.
@@ -584,11 +724,14 @@ type Imm struct {
 func (Imm) isArg() {}
 
 func (i Imm) String() string {
+	return string(i.appendTo(nil))
+}
+
+func (i Imm) appendTo(dst []byte) []byte {
 	if !i.Decimal {
-		return fmt.Sprintf("#%#x", i.Imm)
-	} else {
-		return fmt.Sprintf("#%d", i.Imm)
+		return appendHexImm(dst, uint64(i.Imm))
 	}
+	return strconv.AppendUint(append(dst, '#'), uint64(i.Imm), 10)
 }
 
 type Imm64 struct {
@@ -599,11 +742,14 @@ type Imm64 struct {
 func (Imm64) isArg() {}
 
 func (i Imm64) String() string {
+	return string(i.appendTo(nil))
+}
+
+func (i Imm64) appendTo(dst []byte) []byte {
 	if !i.Decimal {
-		return fmt.Sprintf("#%#x", i.Imm)
-	} else {
-		return fmt.Sprintf("#%d", i.Imm)
+		return appendHexImm(dst, i.Imm)
 	}
+	return strconv.AppendUint(append(dst, '#'), i.Imm, 10)
 }
 
 // An Imm_hint is an integer constant for HINT instruction.
@@ -817,6 +963,14 @@ type Systemreg struct {
 func (Systemreg) isArg() {}
 
 func (s Systemreg) String() string {
+	if name := extSysregName(s); name != "" {
+		return name
+	}
+	return s.encName()
+}
+
+// encName returns the generic name of s, which spells out its encoding.
+func (s Systemreg) encName() string {
 	return fmt.Sprintf("S%d_%d_C%d_C%d_%d",
 		s.op0, s.op1, s.cn, s.cm, s.op2)
 }
@@ -911,27 +1065,47 @@ type RegisterWithArrangement struct {
 func (RegisterWithArrangement) isArg() {}
 
 func (r RegisterWithArrangement) String() string {
-	result := r.r.String()
-	result += r.a.String()
-	if r.cnt > 0 {
-		result = "{" + result
-		if r.cnt == 2 {
-			r1 := V0 + Reg((uint16(r.r)-uint16(V0)+1)&31)
-			result += ", " + r1.String() + r.a.String()
-		} else if r.cnt > 2 {
-			if (uint16(r.cnt) + ((uint16(r.r) - uint16(V0)) & 31)) > 32 {
-				for i := 1; i < int(r.cnt); i++ {
-					cur := V0 + Reg((uint16(r.r)-uint16(V0)+uint16(i))&31)
-					result += ", " + cur.String() + r.a.String()
-				}
-			} else {
-				r1 := V0 + Reg((uint16(r.r)-uint16(V0)+uint16(r.cnt)-1)&31)
-				result += "-" + r1.String() + r.a.String()
+	return string(r.appendTo(nil, nil, -1))
+}
+
+func (r RegisterWithArrangement) appendTo(dst []byte, ts *tokens, arg int) []byte {
+	return appendRegList(dst, r.r, r.a, r.cnt, ts, arg)
+}
+
+// appendRegList appends the register r with arrangement a to dst,
+// or, if cnt > 0, the list of cnt consecutive registers starting at r,
+// and marks the tokens in ts as part of argument arg.
+func appendRegList(dst []byte, r Reg, a Arrangement, cnt uint8, ts *tokens, arg int) []byte {
+	reg := func(dst []byte, sep string, r Reg) []byte {
+		dst = appendTok(dst, ts, TokenText, sep, arg)
+		start := len(dst)
+		dst = r.appendTo(dst)
+		ts.mark(TokenRegister, dst, start, arg)
+		return appendTok(dst, ts, TokenText, a.String(), arg)
+	}
+	open := ""
+	if cnt > 0 {
+		open = "{"
+	}
+	dst = reg(dst, open, r)
+	if cnt == 2 {
+		r1 := V0 + Reg((uint16(r)-uint16(V0)+1)&31)
+		dst = reg(dst, ", ", r1)
+	} else if cnt > 2 {
+		if (uint16(cnt) + ((uint16(r) - uint16(V0)) & 31)) > 32 {
+			for i := 1; i < int(cnt); i++ {
+				cur := V0 + Reg((uint16(r)-uint16(V0)+uint16(i))&31)
+				dst = reg(dst, ", ", cur)
 			}
+		} else {
+			r1 := V0 + Reg((uint16(r)-uint16(V0)+uint16(cnt)-1)&31)
+			dst = reg(dst, "-", r1)
 		}
-		result += "}"
 	}
-	return result
+	if cnt > 0 {
+		dst = appendTok(dst, ts, TokenText, "}", arg)
+	}
+	return dst
 }
 
 // Register with arrangement and index:
@@ -948,27 +1122,16 @@ type RegisterWithArrangementAndIndex struct {
 func (RegisterWithArrangementAndIndex) isArg() {}
 
 func (r RegisterWithArrangementAndIndex) String() string {
-	result := r.r.String()
-	result += r.a.String()
-	if r.cnt > 0 {
-		result = "{" + result
-		if r.cnt == 2 {
-			r1 := V0 + Reg((uint16(r.r)-uint16(V0)+1)&31)
-			result += ", " + r1.String() + r.a.String()
-		} else if r.cnt > 2 {
-			if (uint16(r.cnt) + ((uint16(r.r) - uint16(V0)) & 31)) > 32 {
-				for i := 1; i < int(r.cnt); i++ {
-					cur := V0 + Reg((uint16(r.r)-uint16(V0)+uint16(i))&31)
-					result += ", " + cur.String() + r.a.String()
-				}
-			} else {
-				r1 := V0 + Reg((uint16(r.r)-uint16(V0)+uint16(r.cnt)-1)&31)
-				result += "-" + r1.String() + r.a.String()
-			}
-		}
-		result += "}"
-	}
-	return fmt.Sprintf("%s[%d]", result, r.index)
+	return string(r.appendTo(nil, nil, -1))
+}
+
+func (r RegisterWithArrangementAndIndex) appendTo(dst []byte, ts *tokens, arg int) []byte {
+	dst = appendRegList(dst, r.r, r.a, r.cnt, ts, arg)
+	dst = appendTok(dst, ts, TokenText, "[", arg)
+	start := len(dst)
+	dst = strconv.AppendUint(dst, uint64(r.index), 10)
+	ts.mark(TokenImmediate, dst, start, arg)
+	return appendTok(dst, ts, TokenText, "]", arg)
 }
 
 type sysOp struct {
@@ -980,13 +1143,20 @@ type sysOp struct {
 func (s sysOp) isArg() {}
 
 func (s sysOp) String() string {
-	result := s.op.String()
+	return string(s.appendTo(nil, nil, -1))
+}
+
+func (s sysOp) appendTo(dst []byte, ts *tokens, arg int) []byte {
+	dst = appendTok(dst, ts, TokenText, s.op.String(), arg)
 	// If s.hasOperand2 is false, the value in the register
 	// specified by s.r is ignored.
 	if s.hasOperand2 {
-		result += ", " + s.r.String()
+		dst = appendTok(dst, ts, TokenText, ", ", arg)
+		start := len(dst)
+		dst = s.r.appendTo(dst)
+		ts.mark(TokenRegister, dst, start, arg)
 	}
-	return result
+	return dst
 }
 
 type sysInstFields struct {
@@ -0,0 +1,209 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "golang.org/x/arch/internal/asmarg"
+
+// An ArgKind classifies an instruction argument. The x86asm, armasm
+// and ppc64asm packages share the same type and kinds. Many argument
+// types of this package have unexported fields; their Kind methods,
+// ImmValue, RegValue, MemValue and PCRelTarget give access to their meaning without
+// formatting them.
+type ArgKind = asmarg.Kind
+
+const (
+	KindOther   = asmarg.Other   // none of the other kinds
+	KindReg     = asmarg.Reg     // register, possibly shifted, extended or with an arrangement
+	KindImm     = asmarg.Imm     // immediate constant, including named ones such as barrier options
+	KindMem     = asmarg.Mem     // memory reference
+	KindPCRel   = asmarg.PCRel   // PC-relative address
+	KindAddr    = asmarg.Addr    // absolute code address; not used on arm64
+	KindRegList = asmarg.RegList // list of vector registers
+	KindCond    = asmarg.Cond    // condition
+	KindSysReg  = asmarg.SysReg  // system register, PSTATE field or system instruction operation
+)
+
+// A RegRef describes a register, as RegValue returns it.
+// It is the same type in all the disassembler packages.
+type RegRef = asmarg.RegRef
+
+// A MemRef describes a memory reference, as MemValue returns it.
+// It is the same type in all the disassembler packages.
+type MemRef = asmarg.MemRef
+
+// Kind returns KindReg.
+func (Reg) Kind() ArgKind { return KindReg }
+
+// Kind returns KindReg.
+func (RegSP) Kind() ArgKind { return KindReg }
+
+// Kind returns KindReg.
+func (RegExtshiftAmount) Kind() ArgKind { return KindReg }
+
+// Kind returns KindReg, or KindRegList for a list of registers.
+func (r RegisterWithArrangement) Kind() ArgKind {
+	if r.cnt > 0 {
+		return KindRegList
+	}
+	return KindReg
+}
+
+// Kind returns KindReg, or KindRegList for a list of registers.
+func (r RegisterWithArrangementAndIndex) Kind() ArgKind {
+	if r.cnt > 0 {
+		return KindRegList
+	}
+	return KindReg
+}
+
+// Kind returns KindImm.
+func (Imm) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm64) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (ImmShift) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm_fp) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm_hint) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm_clrex) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm_dcps) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm_c) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm_option) Kind() ArgKind { return KindImm }
+
+// Kind returns KindImm.
+func (Imm_prfop) Kind() ArgKind { return KindImm }
+
+// Kind returns KindMem.
+func (MemImmediate) Kind() ArgKind { return KindMem }
+
+// Kind returns KindMem.
+func (MemExtend) Kind() ArgKind { return KindMem }
+
+// Kind returns KindPCRel.
+func (PCRel) Kind() ArgKind { return KindPCRel }
+
+// Kind returns KindCond.
+func (Cond) Kind() ArgKind { return KindCond }
+
+// Kind returns KindSysReg.
+func (Systemreg) Kind() ArgKind { return KindSysReg }
+
+// Kind returns KindSysReg.
+func (Pstatefield) Kind() ArgKind { return KindSysReg }
+
+func (sysOp) Kind() ArgKind { return KindSysReg }
+
+func (sysInstFields) Kind() ArgKind { return KindSysReg }
+
+// ArgKindOf returns the kind of a, as its Kind method reports it.
+// It returns KindOther for nil.
+func ArgKindOf(a Arg) ArgKind {
+	if k, ok := a.(interface{ Kind() ArgKind }); ok {
+		return k.Kind()
+	}
+	return KindOther
+}
+
+// ImmValue returns the value of a, if a is an integer constant.
+// The value of an ImmShift is the shifted value, and that of a named
+// constant, such as an Imm_option, is its encoding.
+func ImmValue(a Arg) (int64, bool) {
+	switch a := a.(type) {
+	case Imm:
+		return int64(a.Imm), true
+	case Imm64:
+		return int64(a.Imm), true
+	case ImmShift:
+		return int64(a.imm) << a.shift, true
+	case Imm_hint:
+		return int64(a), true
+	case Imm_clrex:
+		return int64(a), true
+	case Imm_dcps:
+		return int64(a), true
+	case Imm_c:
+		return int64(a), true
+	case Imm_option:
+		return int64(a), true
+	case Imm_prfop:
+		return int64(a), true
+	}
+	return 0, false
+}
+
+// RegValue returns the register a, if a is a single register.
+// For a RegExtshiftAmount, it returns the register that is shifted
+// or extended, and for a vector register with an arrangement, the
+// vector register. Num is the register number in encodings, from
+// 0 to 31; SP and WSP are 31.
+func RegValue(a Arg) (RegRef, bool) {
+	var r Reg
+	name := ""
+	switch a := a.(type) {
+	case Reg:
+		r = a
+	case RegSP:
+		r, name = Reg(a), a.String()
+	case RegExtshiftAmount:
+		r = a.reg
+	case RegisterWithArrangement:
+		if a.cnt > 0 {
+			return RegRef{}, false
+		}
+		r = a.r
+	case RegisterWithArrangementAndIndex:
+		if a.cnt > 0 {
+			return RegRef{}, false
+		}
+		r = a.r
+	default:
+		return RegRef{}, false
+	}
+	if name == "" {
+		name = r.String()
+	}
+	return RegRef{Name: name, Num: int(r) & 31}, true
+}
+
+// MemValue returns the memory reference inst.Args[i], if that
+// argument is one that a MemRef can describe. A MemRef cannot
+// describe a 32-bit index register that is sign or zero extended;
+// use the MemExtend fields for those. For post-indexed addressing,
+// the address accessed is the base register alone.
+func MemValue(inst Inst, i int) (MemRef, bool) {
+	switch m := inst.Args[i].(type) {
+	case MemImmediate:
+		ref := MemRef{Base: m.Base.String()}
+		if m.Mode == AddrOffset || m.Mode == AddrPreIndex {
+			ref.Disp = int64(m.imm)
+		}
+		return ref, true
+	case MemExtend:
+		switch m.Extend {
+		case lsl, uxtx, sxtx:
+		default:
+			return MemRef{}, false
+		}
+		ref := MemRef{Base: m.Base.String(), Index: m.Index.String(), Scale: 1}
+		if !m.ShiftMustBeZero {
+			ref.Scale <<= m.Amount
+		}
+		return ref, true
+	}
+	return MemRef{}, false
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestArgKindOf(t *testing.T) {
+	tests := []struct {
+		enc   uint32
+		kinds string
+		imm   int64
+	}{
+		{0x4c40a000, "RegList Mem", 0},      // ld1 {v0.16b, v1.16b}, [x0]
+		{0xd53bd040, "Reg SysReg", 0},       // mrs x0, tpidr_el0
+		{0x91400420, "Reg Reg Imm", 0x1000}, // add x0, x1, #0x1, lsl #12
+		{0x9a820020, "Reg Reg Reg Cond", 0}, // csel x0, x1, x2, eq
+		{0x14000002, "PCRel", 0},            // b .+8
+		{0xd5033bbf, "Imm", 0xb},            // dmb ish
+		{0x8b220c20, "Reg Reg Reg", 0},      // add x0, x1, w2, uxtb #3
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:])
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		var kinds []string
+		for _, a := range inst.Args {
+			if a == nil {
+				break
+			}
+			kinds = append(kinds, ArgKindOf(a).String())
+			if v, ok := ImmValue(a); ok && v != tt.imm {
+				t.Errorf("%v: ImmValue(%v) = %d, want %d", inst, a, v, tt.imm)
+			}
+		}
+		if s := strings.Join(kinds, " "); s != tt.kinds {
+			t.Errorf("%v: kinds %q, want %q", inst, s, tt.kinds)
+		}
+	}
+}
+
+func TestArgValues(t *testing.T) {
+	tests := []struct {
+		enc uint32
+		i   int
+		reg RegRef
+		mem MemRef
+	}{
+		{0xf9400420, 0, RegRef{Name: "X0", Num: 0}, MemRef{}}, // ldr x0, [x1, #8]
+		{0xf9400420, 1, RegRef{}, MemRef{Base: "X1", Disp: 8}},
+		{0xf8410c20, 1, RegRef{}, MemRef{Base: "X1", Disp: 16}},              // ldr x0, [x1, #16]!
+		{0xf8408420, 1, RegRef{}, MemRef{Base: "X1"}},                        // ldr x0, [x1], #8
+		{0xf8627820, 1, RegRef{}, MemRef{Base: "X1", Index: "X2", Scale: 8}}, // ldr x0, [x1, x2, lsl #3]
+		{0xb8624820, 1, RegRef{}, MemRef{}},                                  // ldr w0, [x1, w2, uxtw]
+		{0x910003e0, 1, RegRef{Name: "SP", Num: 31}, MemRef{}},               // mov x0, sp
+		{0x8b220c20, 2, RegRef{Name: "W2", Num: 2}, MemRef{}},                // add x0, x1, w2, uxtb #3
+		{0x4ea11c20, 1, RegRef{Name: "V1", Num: 1}, MemRef{}},                // mov v0.16b, v1.16b
+		{0x4c40a000, 0, RegRef{}, MemRef{}},                                  // ld1 {v0.16b, v1.16b}, [x0]
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:])
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		reg, ok := RegValue(inst.Args[tt.i])
+		if ok != (tt.reg != RegRef{}) || reg != tt.reg {
+			t.Errorf("%v: RegValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, reg, ok, tt.reg)
+		}
+		mem, ok := MemValue(inst, tt.i)
+		if ok != (tt.mem != MemRef{}) || mem != tt.mem {
+			t.Errorf("%v: MemValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, mem, ok, tt.mem)
+		}
+	}
+}
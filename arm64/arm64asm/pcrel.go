@@ -0,0 +1,216 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "fmt"
+
+// PCRelTarget returns the absolute address referred to by the PC-relative
+// argument of inst, assuming inst is located at pc.
+// For ADRP the result is the address of the 4 KiB page, not of the datum
+// within it; see FuseADRP for computing the full address.
+// The boolean result reports whether inst has a PC-relative argument.
+func PCRelTarget(inst Inst, pc uint64) (uint64, bool) {
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		if rel, ok := a.(PCRel); ok {
+			if inst.Op == ADRP {
+				return pc&^(1<<12-1) + uint64(rel), true
+			}
+			return pc + uint64(rel), true
+		}
+	}
+	return 0, false
+}
+
+// LiteralLoad reports the address and size in bytes of the literal
+// loaded by inst, a load (literal) instruction located at pc.
+// For PRFM (literal) the size is 0.
+// The boolean result reports whether inst is a load (literal) instruction.
+func LiteralLoad(inst Inst, pc uint64) (addr uint64, size int, ok bool) {
+	switch inst.Op {
+	case LDR, LDRSW, PRFM:
+	default:
+		return 0, 0, false
+	}
+	if _, ok := inst.Args[1].(PCRel); !ok {
+		return 0, 0, false
+	}
+	addr, _ = PCRelTarget(inst, pc)
+	if inst.Op == LDRSW {
+		return addr, 4, true
+	}
+	if r, ok := inst.Args[0].(Reg); ok {
+		size = regBytes(r)
+	}
+	return addr, size, true
+}
+
+// regBytes returns the size in bytes of the general-purpose
+// or scalar floating-point register r.
+func regBytes(r Reg) int {
+	switch {
+	case W0 <= r && r <= WZR, S0 <= r && r <= S31:
+		return 4
+	case X0 <= r && r <= XZR, D0 <= r && r <= D31:
+		return 8
+	case B0 <= r && r <= B31:
+		return 1
+	case H0 <= r && r <= H31:
+		return 2
+	case Q0 <= r && r <= Q31:
+		return 16
+	}
+	return 0
+}
+
+// FuseADRP computes the address materialized by the instruction pair
+// formed by adrp, an ADRP located at pc, and next, the instruction
+// that consumes its result. The pair is recognized when next is an
+// ADD (immediate) or a load or store with an unsigned offset whose
+// base register is the destination of the ADRP.
+// The boolean result reports whether the pair was recognized.
+func FuseADRP(adrp Inst, pc uint64, next Inst) (uint64, bool) {
+	if adrp.Op != ADRP {
+		return 0, false
+	}
+	rd, ok := adrp.Args[0].(Reg)
+	if !ok {
+		return 0, false
+	}
+	page, _ := PCRelTarget(adrp, pc)
+
+	switch next.Op {
+	case ADD:
+		rn, ok := next.Args[1].(RegSP)
+		if !ok || Reg(rn) != rd {
+			return 0, false
+		}
+		imm, ok := next.Args[2].(ImmShift)
+		if !ok || imm.shift != 0 && imm.shift != 12 {
+			return 0, false
+		}
+		return page + uint64(imm.imm)<<imm.shift, true
+	}
+
+	for _, a := range next.Args {
+		if a == nil {
+			break
+		}
+		if mem, ok := a.(MemImmediate); ok {
+			if mem.Mode != AddrOffset || Reg(mem.Base) != rd {
+				return 0, false
+			}
+			return page + uint64(int64(mem.imm)), true
+		}
+	}
+	return 0, false
+}
+
+// Annotate appends an objdump-style comment naming addr to text,
+// the formatted form of an instruction. The symname function, if not nil,
+// is used to describe addr relative to the symbol containing it.
+// For example:
+//
+//	ldr x0, .+0x20	// 0x10020 <runtime.foo+0x10>
+func Annotate(text string, addr uint64, symname func(uint64) (string, uint64)) string {
+	comment := fmt.Sprintf("%#x", addr)
+	if symname != nil {
+		if s, base := symname(addr); s != "" {
+			if addr == base {
+				comment += fmt.Sprintf(" <%s>", s)
+			} else {
+				comment += fmt.Sprintf(" <%s+%#x>", s, addr-base)
+			}
+		}
+	}
+	return text + "\t// " + comment
+}
+
+// A PCRange is the range of offsets that the PC-relative argument
+// of an instruction can encode.
+type PCRange struct {
+	Min, Max int64 // inclusive bounds
+	Align    int64 // offsets are multiples of Align
+}
+
+// Contains reports whether r can encode off.
+func (r PCRange) Contains(off int64) bool {
+	return r.Min <= off && off <= r.Max && off%r.Align == 0
+}
+
+// Margin returns the distance from off to the nearer bound of r,
+// or a negative distance if off is outside r. It ignores alignment.
+func (r PCRange) Margin(off int64) int64 {
+	if off-r.Min < r.Max-off {
+		return off - r.Min
+	}
+	return r.Max - off
+}
+
+// PCRangeOf returns the range of offsets that the PC-relative argument
+// of inst can encode: ±128 MiB for B and BL, ±1 MiB for B.cond, CBZ,
+// CBNZ, ADR and load (literal), ±32 KiB for TBZ and TBNZ, and ±4 GiB
+// for ADRP, whose offset is from the 4 KiB page of the instruction to
+// the page of the target.
+// The boolean result reports whether inst has a PC-relative argument.
+func PCRangeOf(inst Inst) (PCRange, bool) {
+	x := inst.Enc
+	switch {
+	case x&0x7c000000 == 0x14000000: // B, BL
+		return PCRange{-1 << 27, 1<<27 - 4, 4}, true
+	case x&0xff000000 == 0x54000000, // B.cond, BC.cond
+		x&0x7e000000 == 0x34000000, // CBZ, CBNZ
+		x&0x3b000000 == 0x18000000: // load (literal), PRFM (literal)
+		return PCRange{-1 << 20, 1<<20 - 4, 4}, true
+	case x&0x7e000000 == 0x36000000: // TBZ, TBNZ
+		return PCRange{-1 << 15, 1<<15 - 4, 4}, true
+	case x&0x9f000000 == 0x10000000: // ADR
+		return PCRange{-1 << 20, 1<<20 - 1, 1}, true
+	case x&0x9f000000 == 0x90000000: // ADRP
+		return PCRange{-1 << 32, 1<<32 - 1<<12, 1 << 12}, true
+	}
+	return PCRange{}, false
+}
+
+// A RangeError reports that an instruction cannot refer to a target.
+type RangeError struct {
+	Op     Op
+	PC     uint64  // address of the instruction
+	Target uint64  // address it must refer to
+	Offset int64   // offset it would need to encode
+	Range  PCRange // offsets it can encode
+}
+
+func (e *RangeError) Error() string {
+	if e.Offset%e.Range.Align != 0 {
+		return fmt.Sprintf("%v at %#x: offset %#x to %#x is not a multiple of %d", e.Op, e.PC, e.Offset, e.Target, e.Range.Align)
+	}
+	return fmt.Sprintf("%v at %#x: offset %#x to %#x is out of range [%#x, %#x]", e.Op, e.PC, e.Offset, e.Target, e.Range.Min, e.Range.Max)
+}
+
+// CheckReach reports whether the PC-relative argument of inst, located
+// at pc, can refer to target, as a linker placing a veneer or a JIT
+// patching a branch needs to know. It returns the margin of the offset
+// within the range the instruction can encode, as computed by
+// PCRange.Margin, and a *RangeError if the offset is out of range or
+// misaligned. It returns an error of another type if inst has no
+// PC-relative argument.
+func CheckReach(inst Inst, pc, target uint64) (margin int64, err error) {
+	r, ok := PCRangeOf(inst)
+	if !ok {
+		return 0, fmt.Errorf("%v has no PC-relative argument", inst.Op)
+	}
+	off := int64(target - pc)
+	if inst.Op == ADRP {
+		off = int64(target&^(1<<12-1) - pc&^(1<<12-1))
+	}
+	margin = r.Margin(off)
+	if !r.Contains(off) {
+		return margin, &RangeError{inst.Op, pc, target, off, r}
+	}
+	return margin, nil
+}
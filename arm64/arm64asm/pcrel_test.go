@@ -0,0 +1,160 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+)
+
+func decodeWord(t *testing.T, x uint32) Inst {
+	t.Helper()
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], x)
+	inst, err := Decode(b[:])
+	if err != nil {
+		t.Fatalf("Decode(%#08x): %v", x, err)
+	}
+	return inst
+}
+
+func TestLiteralLoad(t *testing.T) {
+	var tests = []struct {
+		enc  uint32
+		addr uint64
+		size int
+		ok   bool
+	}{
+		{0x58000041, 0x10008, 8, true},  // ldr x1, .+0x8
+		{0x18000041, 0x10008, 4, true},  // ldr w1, .+0x8
+		{0x98000041, 0x10008, 4, true},  // ldrsw x1, .+0x8
+		{0x9c000041, 0x10008, 16, true}, // ldr q1, .+0x8
+		{0xd8000040, 0x10008, 0, true},  // prfm pldl1keep, .+0x8
+		{0xf9400401, 0, 0, false},       // ldr x1, [x0,#8]
+	}
+	for _, tt := range tests {
+		inst := decodeWord(t, tt.enc)
+		addr, size, ok := LiteralLoad(inst, 0x10000)
+		if addr != tt.addr || size != tt.size || ok != tt.ok {
+			t.Errorf("LiteralLoad(%v) = %#x, %d, %v, want %#x, %d, %v", inst, addr, size, ok, tt.addr, tt.size, tt.ok)
+		}
+	}
+}
+
+func TestFuseADRP(t *testing.T) {
+	adrp := decodeWord(t, 0xb0000000) // adrp x0, .+0x1000
+	if addr, ok := PCRelTarget(adrp, 0x10abc); !ok || addr != 0x11000 {
+		t.Errorf("PCRelTarget(%v) = %#x, %v, want 0x11000, true", adrp, addr, ok)
+	}
+	var tests = []struct {
+		enc  uint32
+		addr uint64
+		ok   bool
+	}{
+		{0x91004000, 0x11010, true}, // add x0, x0, #0x10
+		{0x91404000, 0x21000, true}, // add x0, x0, #0x10, lsl #12
+		{0xf9400401, 0x11008, true}, // ldr x1, [x0,#8]
+		{0xf9000401, 0x11008, true}, // str x1, [x0,#8]
+		{0x91004020, 0, false},      // add x0, x1, #0x10
+		{0xf8408401, 0, false},      // ldr x1, [x0],#8
+		{0xaa0103e0, 0, false},      // mov x0, x1
+	}
+	for _, tt := range tests {
+		next := decodeWord(t, tt.enc)
+		addr, ok := FuseADRP(adrp, 0x10abc, next)
+		if addr != tt.addr || ok != tt.ok {
+			t.Errorf("FuseADRP(%v, %v) = %#x, %v, want %#x, %v", adrp, next, addr, ok, tt.addr, tt.ok)
+		}
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		if 0x11000 <= addr && addr < 0x11100 {
+			return "foo", 0x11000
+		}
+		return "", 0
+	}
+	var tests = []struct {
+		addr uint64
+		want string
+	}{
+		{0x11000, "add x0, x0, #0x10\t// 0x11000 <foo>"},
+		{0x11010, "add x0, x0, #0x10\t// 0x11010 <foo+0x10>"},
+		{0x20000, "add x0, x0, #0x10\t// 0x20000"},
+	}
+	for _, tt := range tests {
+		if got := Annotate("add x0, x0, #0x10", tt.addr, symname); got != tt.want {
+			t.Errorf("Annotate(%#x) = %q, want %q", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestPCRangeOf(t *testing.T) {
+	// Every decoded PC-relative offset must be in its instruction's range.
+	r := rand.New(rand.NewSource(1))
+	var b [4]byte
+	for i := 0; i < 1000000; i++ {
+		binary.LittleEndian.PutUint32(b[:], r.Uint32())
+		inst, err := Decode(b[:])
+		if err != nil {
+			continue
+		}
+		var rel PCRel
+		has := false
+		for _, a := range inst.Args {
+			if a, ok := a.(PCRel); ok {
+				rel, has = a, true
+			}
+		}
+		rng, ok := PCRangeOf(inst)
+		if ok != has {
+			t.Fatalf("PCRangeOf(%v) ok = %v, want %v", inst, ok, has)
+		}
+		if ok && !rng.Contains(int64(rel)) {
+			t.Fatalf("PCRangeOf(%v) = %+v, does not contain %#x", inst, rng, int64(rel))
+		}
+	}
+}
+
+func TestCheckReach(t *testing.T) {
+	const pc = 0x200000000
+	var tests = []struct {
+		enc    uint32
+		target uint64
+		margin int64
+		ok     bool
+	}{
+		{0x14000000, pc + 1<<27 - 4, 0, true},             // b .
+		{0x14000000, pc + 1<<27, -4, false},               // b .
+		{0x94000000, pc - 1<<27, 0, true},                 // bl .
+		{0x94000000, pc + 0x100, 1<<27 - 0x104, true},     // bl .
+		{0x94000000, pc + 2, 1<<27 - 6, false},            // bl . (misaligned)
+		{0x54000000, pc + 0x80000, 1<<20 - 0x80004, true}, // b.eq .
+		{0x54000000, pc - 1<<20 - 4, -4, false},           // b.eq .
+		{0x36000000, pc + 0x7ffc, 0, true},                // tbz w0, #0, .
+		{0x36000000, pc + 0x8000, -4, false},              // tbz w0, #0, .
+		{0x58000001, pc + 1<<20, -4, false},               // ldr x1, .
+		{0x10000000, pc + 1<<20 - 1, 0, true},             // adr x0, .
+		{0x10000000, pc + 1<<20, -1, false},               // adr x0, .
+		{0x90000000, pc + 0xfff, 1<<32 - 1<<12, true},     // adrp x0, .
+		{0x90000000, pc - 1<<32, 0, true},                 // adrp x0, .
+		{0x90000000, pc + 1<<32, -1 << 12, false},         // adrp x0, .
+	}
+	for _, tt := range tests {
+		inst := decodeWord(t, tt.enc)
+		margin, err := CheckReach(inst, pc, tt.target)
+		if margin != tt.margin || (err == nil) != tt.ok {
+			t.Errorf("CheckReach(%v, %#x, %#x) = %#x, %v, want %#x, ok=%v", inst, uint64(pc), tt.target, margin, err, tt.margin, tt.ok)
+		}
+		if _, isRange := err.(*RangeError); err != nil && !isRange {
+			t.Errorf("CheckReach(%v, %#x, %#x): error %T, want *RangeError", inst, uint64(pc), tt.target, err)
+		}
+	}
+	if _, err := CheckReach(decodeWord(t, 0xaa0103e0), pc, pc); err == nil { // mov x0, x1
+		t.Errorf("CheckReach(mov x0, x1): no error")
+	}
+}
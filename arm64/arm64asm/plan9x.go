@@ -22,16 +22,20 @@ import (
 // The reader text should read from the text segment using text addresses
 // as offsets; it is used to display pc-relative loads as constant loads.
 func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) string {
+	return goTokens(inst, pc, symname, text).String()
+}
+
+func goTokens(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) tokens {
 	if symname == nil {
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
 
-	var args []string
-	for _, a := range inst.Args {
+	var args []tokens
+	for i, a := range inst.Args {
 		if a == nil {
 			break
 		}
-		args = append(args, plan9Arg(&inst, pc, symname, a))
+		args = append(args, plan9Arg(&inst, pc, symname, a).arg(i))
 	}
 
 	op := inst.Op.String()
@@ -45,7 +49,7 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 				break
 			}
 			if s, base := symname(addr); s != "" && addr == base {
-				args[1] = fmt.Sprintf("$%s(SB)", s)
+				args[1] = tokens{{TokenText, "$", 1}, {TokenSymbol, s, 1}, {TokenText, "(SB)", 1}}
 			}
 		}
 	}
@@ -82,28 +86,28 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 
 	switch inst.Op {
 	case BL:
-		return "CALL " + args[0]
+		return opTokens("CALL", args[0])
 
 	case BLR:
 		r := inst.Args[0].(Reg)
 		regno := uint16(r) & 31
-		return fmt.Sprintf("CALL (R%d)", regno)
+		return opTokens("CALL", regIndirect(regno))
 
 	case RET:
 		if r, ok := inst.Args[0].(Reg); ok && r == X30 {
-			return "RET"
+			return tok(TokenMnemonic, "RET")
 		}
 
 	case B:
 		if cond, ok := inst.Args[0].(Cond); ok {
-			return "B" + cond.String() + " " + args[1]
+			return opTokens("B"+cond.String(), args[1])
 		}
-		return "JMP" + " " + args[0]
+		return opTokens("JMP", args[0])
 
 	case BR:
 		r := inst.Args[0].(Reg)
 		regno := uint16(r) & 31
-		return fmt.Sprintf("JMP (R%d)", regno)
+		return opTokens("JMP", regIndirect(regno))
 
 	case MOV:
 		rno := -1
@@ -137,19 +141,19 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 			op = "MOVWU" + suffix
 		} else if rno >= uint16(B0) && rno <= uint16(B31) {
 			op = "FMOVB" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(H0) && rno <= uint16(H31) {
 			op = "FMOVH" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(S0) && rno <= uint16(S31) {
 			op = "FMOVS" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(D0) && rno <= uint16(D31) {
 			op = "FMOVD" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(Q0) && rno <= uint16(Q31) {
 			op = "FMOVQ" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else {
 			op = "MOVD" + suffix
 		}
@@ -192,19 +196,19 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 			op = "MOVW" + suffix
 		} else if rno >= uint16(B0) && rno <= uint16(B31) {
 			op = "FMOVB" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(H0) && rno <= uint16(H31) {
 			op = "FMOVH" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(S0) && rno <= uint16(S31) {
 			op = "FMOVS" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(D0) && rno <= uint16(D31) {
 			op = "FMOVD" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else if rno >= uint16(Q0) && rno <= uint16(Q31) {
 			op = "FMOVQ" + suffix
-			args[0] = fmt.Sprintf("F%d", rno&31)
+			args[0] = fpReg(rno)
 		} else {
 			op = "MOVD" + suffix
 		}
@@ -269,12 +273,12 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 				op += "W"
 			}
 		}
-		args[0] = fmt.Sprintf("(%s, %s)", args[0], args[1])
+		args[0] = regPair(args[0], args[1])
 		args[1] = args[2]
-		return op + " " + args[1] + ", " + args[0]
+		return opTokens(op, args[1], args[0])
 
 	case STP, LDP:
-		args[0] = fmt.Sprintf("(%s, %s)", args[0], args[1])
+		args[0] = regPair(args[0], args[1])
 		args[1] = args[2]
 
 		rno, ok := inst.Args[0].(Reg)
@@ -292,9 +296,9 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		}
 		op = op + suffix
 		if inst.Op.String() == "STP" {
-			return op + " " + args[0] + ", " + args[1]
+			return opTokens(op, args[0], args[1])
 		} else {
-			return op + " " + args[1] + ", " + args[0]
+			return opTokens(op, args[1], args[0])
 		}
 
 	case STLXP, STXP:
@@ -304,9 +308,9 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 				op += "W"
 			}
 		}
-		args[1] = fmt.Sprintf("(%s, %s)", args[1], args[2])
+		args[1] = regPair(args[1], args[2])
 		args[2] = args[3]
-		return op + " " + args[1] + ", " + args[2] + ", " + args[0]
+		return opTokens(op, args[1], args[2], args[0])
 
 	case FCCMP, FCCMPE:
 		args[0], args[1] = args[1], args[0]
@@ -314,7 +318,7 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 
 	case FCMP, FCMPE:
 		if _, ok := inst.Args[1].(Imm); ok {
-			args[1] = "$(0.0)"
+			args[1] = tokens{{TokenImmediate, "$(0.0)", 1}}
 		}
 		fallthrough
 
@@ -391,8 +395,7 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		cm := int(inst.Args[3].(Imm_c))
 		op2 := int(inst.Args[4].(Imm).Imm)
 		sysregno := int32(op1<<16 | cn<<12 | cm<<8 | op2<<5)
-		args[1] = fmt.Sprintf("$%d", sysregno)
-		return op + " " + args[1] + ", " + args[0]
+		return opTokens(op, tok(TokenImmediate, fmt.Sprintf("$%d", sysregno)), args[0])
 
 	case CBNZ, CBZ:
 		if r, ok := inst.Args[0].(Reg); ok {
@@ -405,10 +408,10 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 
 	case ADR, ADRP:
 		addr := int64(inst.Args[1].(PCRel))
-		args[1] = fmt.Sprintf("%d(PC)", addr)
+		args[1] = tokens{{TokenAddress, fmt.Sprintf("%d(PC)", addr), 1}}
 
 	case MSR:
-		args[0] = inst.Args[0].String()
+		args[0] = tokens{{TokenRegister, inst.Args[0].String(), 0}}
 
 	case ST1:
 		op = fmt.Sprintf("V%s", op) + suffix
@@ -459,11 +462,44 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		args[i], args[j] = args[j], args[i]
 	}
 
-	if args != nil {
-		op += " " + strings.Join(args, ", ")
+	return opTokens(op, args...)
+}
+
+// opTokens returns the tokens of the mnemonic op followed by args
+// separated by commas.
+func opTokens(op string, args ...tokens) tokens {
+	ts := tok(TokenMnemonic, op)
+	for i, a := range args {
+		if i == 0 {
+			ts.text(" ")
+		} else {
+			ts.text(", ")
+		}
+		ts.addAll(a)
 	}
+	return ts
+}
 
-	return op
+// regIndirect returns the tokens of the register-indirect target (Rn)
+// of argument 0.
+func regIndirect(regno uint16) tokens {
+	return tokens{{TokenText, "(", 0}, {TokenRegister, fmt.Sprintf("R%d", regno), 0}, {TokenText, ")", 0}}
+}
+
+// fpReg returns the tokens of argument 0 as the FP register Fn
+// with the number of rno.
+func fpReg(rno uint16) tokens {
+	return tokens{{TokenRegister, fmt.Sprintf("F%d", rno&31), 0}}
+}
+
+// regPair returns the tokens of the register pair (a, b).
+func regPair(a, b tokens) tokens {
+	ts := tok(TokenText, "(")
+	ts.addAll(a)
+	ts.text(", ")
+	ts.addAll(b)
+	ts.text(")")
+	return ts
 }
 
 // No need add "W" to opcode suffix.
@@ -506,26 +542,26 @@ var fOpsWithoutFPrefix = map[Op]bool{
 	STP: true,
 }
 
-func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) string {
+func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) tokens {
 	switch a := arg.(type) {
 	case Imm:
-		return fmt.Sprintf("$%d", uint32(a.Imm))
+		return tok(TokenImmediate, fmt.Sprintf("$%d", uint32(a.Imm)))
 
 	case Imm64:
-		return fmt.Sprintf("$%d", int64(a.Imm))
+		return tok(TokenImmediate, fmt.Sprintf("$%d", int64(a.Imm)))
 
 	case ImmShift:
 		if a.shift == 0 {
-			return fmt.Sprintf("$%d", a.imm)
+			return tok(TokenImmediate, fmt.Sprintf("$%d", a.imm))
 		}
-		return fmt.Sprintf("$(%d<<%d)", a.imm, a.shift)
+		return tok(TokenImmediate, fmt.Sprintf("$(%d<<%d)", a.imm, a.shift))
 
 	case PCRel:
 		addr := int64(pc) + int64(a)
 		if s, base := symname(uint64(addr)); s != "" && uint64(addr) == base {
-			return fmt.Sprintf("%s(SB)", s)
+			return tokens{{TokenSymbol, s, -1}, {TokenText, "(SB)", -1}}
 		}
-		return fmt.Sprintf("%d(PC)", a/4)
+		return tok(TokenAddress, fmt.Sprintf("%d(PC)", a/4))
 
 	case Reg:
 		regenum := uint16(a)
@@ -535,83 +571,72 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 			if strings.HasPrefix(inst.Op.String(), "F") || strings.HasSuffix(inst.Op.String(), "CVTF") || fOpsWithoutFPrefix[inst.Op] {
 				// FP registers are the same ones as SIMD registers
 				// Print Fn for scalar variant to align with assembler (e.g., FCVT, SCVTF, UCVTF, etc.)
-				return fmt.Sprintf("F%d", regno)
+				return tok(TokenRegister, fmt.Sprintf("F%d", regno))
 			} else {
 				// Print Vn to align with assembler (e.g., SHA256H)
-				return fmt.Sprintf("V%d", regno)
+				return tok(TokenRegister, fmt.Sprintf("V%d", regno))
 			}
 
 		}
-		return plan9gpr(a)
+		return tok(TokenRegister, plan9gpr(a))
 
 	case RegSP:
 		regno := uint16(a) & 31
 		if regno == 31 {
-			return "RSP"
+			return tok(TokenRegister, "RSP")
 		}
-		return fmt.Sprintf("R%d", regno)
+		return tok(TokenRegister, fmt.Sprintf("R%d", regno))
 
 	case RegExtshiftAmount:
-		reg := plan9gpr(a.reg)
-		extshift := ""
-		amount := ""
+		ts := tok(TokenRegister, plan9gpr(a.reg))
 		if a.extShift != ExtShift(0) {
 			switch a.extShift {
 			default:
-				extshift = "." + a.extShift.String()
+				ts.text("." + a.extShift.String())
 
 			case lsl:
-				extshift = "<<"
-				amount = fmt.Sprintf("%d", a.amount)
-				return reg + extshift + amount
+				ts.text("<<")
+				ts.add(TokenImmediate, fmt.Sprintf("%d", a.amount))
+				return ts
 
 			case lsr:
-				extshift = ">>"
-				amount = fmt.Sprintf("%d", a.amount)
-				return reg + extshift + amount
+				ts.text(">>")
+				ts.add(TokenImmediate, fmt.Sprintf("%d", a.amount))
+				return ts
 
 			case asr:
-				extshift = "->"
-				amount = fmt.Sprintf("%d", a.amount)
-				return reg + extshift + amount
+				ts.text("->")
+				ts.add(TokenImmediate, fmt.Sprintf("%d", a.amount))
+				return ts
 			case ror:
-				extshift = "@>"
-				amount = fmt.Sprintf("%d", a.amount)
-				return reg + extshift + amount
+				ts.text("@>")
+				ts.add(TokenImmediate, fmt.Sprintf("%d", a.amount))
+				return ts
 			}
 			if a.amount != 0 {
-				amount = fmt.Sprintf("<<%d", a.amount)
+				ts.text("<<")
+				ts.add(TokenImmediate, fmt.Sprintf("%d", a.amount))
 			}
 		}
-		return reg + extshift + amount
+		return ts
 
 	case MemImmediate:
-		off := ""
-		base := ""
-		regno := uint16(a.Base) & 31
-		if regno == 31 {
-			base = "(RSP)"
-		} else {
-			base = fmt.Sprintf("(R%d)", regno)
-		}
+		var ts tokens
 		if a.imm != 0 && a.Mode != AddrPostReg {
-			off = fmt.Sprintf("%d", a.imm)
-		} else if a.Mode == AddrPostReg {
-			postR := fmt.Sprintf("(R%d)", a.imm)
-			return base + postR
+			ts.add(TokenImmediate, fmt.Sprintf("%d", a.imm))
 		}
-		return off + base
+		ts.addAll(plan9Base(a.Base))
+		if a.Mode == AddrPostReg {
+			ts.text("(")
+			ts.add(TokenRegister, fmt.Sprintf("R%d", a.imm))
+			ts.text(")")
+		}
+		return ts
 
 	case MemExtend:
-		base := ""
-		index := ""
-		regno := uint16(a.Base) & 31
-		if regno == 31 {
-			base = "(RSP)"
-		} else {
-			base = fmt.Sprintf("(R%d)", regno)
-		}
-		indexreg := plan9gpr(a.Index)
+		ts := plan9Base(a.Base)
+		ts.text("(")
+		ts.add(TokenRegister, plan9gpr(a.Index))
 
 		if a.Extend == lsl {
 			// Refer to ARM reference manual, for byte load/store(register), the index
@@ -621,43 +646,43 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 			// When a.ShiftMustBeZero is true, GNU syntax prints "[Xn, Xm lsl #0]" if "S"
 			// equals to 1, or prints "[Xn, Xm]" if "S" equals to 0.
 			if a.Amount != 0 && !a.ShiftMustBeZero {
-				index = fmt.Sprintf("(%s<<%d)", indexreg, a.Amount)
+				ts.text("<<")
+				ts.add(TokenImmediate, fmt.Sprintf("%d", a.Amount))
 			} else if a.ShiftMustBeZero && a.Amount == 1 {
 				// When a.ShiftMustBeZero is ture, Go syntax prints "(Rm<<0)" if "a.Amount"
 				// equals to 1.
-				index = fmt.Sprintf("(%s<<0)", indexreg)
-			} else {
-				index = fmt.Sprintf("(%s)", indexreg)
+				ts.text("<<")
+				ts.add(TokenImmediate, "0")
 			}
 		} else {
+			ts.text("." + a.Extend.String())
 			if a.Amount != 0 && !a.ShiftMustBeZero {
-				index = fmt.Sprintf("(%s.%s<<%d)", indexreg, a.Extend.String(), a.Amount)
-			} else {
-				index = fmt.Sprintf("(%s.%s)", indexreg, a.Extend.String())
+				ts.text("<<")
+				ts.add(TokenImmediate, fmt.Sprintf("%d", a.Amount))
 			}
 		}
-
-		return base + index
+		ts.text(")")
+		return ts
 
 	case Cond:
 		switch arg.String() {
 		case "CS":
-			return "HS"
+			return tok(TokenText, "HS")
 		case "CC":
-			return "LO"
+			return tok(TokenText, "LO")
 		}
 
 	case Imm_clrex:
-		return fmt.Sprintf("$%d", uint32(a))
+		return tok(TokenImmediate, fmt.Sprintf("$%d", uint32(a)))
 
 	case Imm_dcps:
-		return fmt.Sprintf("$%d", uint32(a))
+		return tok(TokenImmediate, fmt.Sprintf("$%d", uint32(a)))
 
 	case Imm_option:
-		return fmt.Sprintf("$%d", uint8(a))
+		return tok(TokenImmediate, fmt.Sprintf("$%d", uint8(a)))
 
 	case Imm_hint:
-		return fmt.Sprintf("$%d", uint8(a))
+		return tok(TokenImmediate, fmt.Sprintf("$%d", uint8(a)))
 
 	case Imm_fp:
 		var s, pre, numerator, denominator int16
@@ -676,10 +701,9 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 			denominator = (16 << uint8(-1*a.exp))
 		}
 		result = float64(numerator) / float64(denominator)
-		return strings.TrimRight(fmt.Sprintf("$%f", result), "0")
+		return tok(TokenImmediate, strings.TrimRight(fmt.Sprintf("$%f", result), "0"))
 
 	case RegisterWithArrangement:
-		result := a.r.String()
 		arrange := a.a.String()
 		c := []rune(arrange)
 		switch len(c) {
@@ -689,47 +713,49 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 			c[1], c[2], c[3] = c[3], c[1], c[2] // 16B -> B16
 		}
 		arrange = string(c)
-		result += arrange
-		if a.cnt > 0 {
-			result = "[" + result
-			for i := 1; i < int(a.cnt); i++ {
-				cur := V0 + Reg((uint16(a.r)-uint16(V0)+uint16(i))&31)
-				result += ", " + cur.String() + arrange
-			}
-			result += "]"
-		}
-		return result
+		return regListTokens(a.r, arrange, a.cnt)
 
 	case RegisterWithArrangementAndIndex:
-		result := a.r.String()
-		arrange := a.a.String()
-		result += arrange
-		if a.cnt > 1 {
-			result = "[" + result
-			for i := 1; i < int(a.cnt); i++ {
-				cur := V0 + Reg((uint16(a.r)-uint16(V0)+uint16(i))&31)
-				result += ", " + cur.String() + arrange
-			}
-			result += "]"
+		cnt := a.cnt
+		if cnt == 1 {
+			cnt = 0
 		}
-		return fmt.Sprintf("%s[%d]", result, a.index)
+		ts := regListTokens(a.r, a.a.String(), cnt)
+		ts.text("[")
+		ts.add(TokenImmediate, fmt.Sprintf("%d", a.index))
+		ts.text("]")
+		return ts
 
 	case Systemreg:
-		return fmt.Sprintf("$%d", uint32(a.op0&1)<<14|uint32(a.op1&7)<<11|uint32(a.cn&15)<<7|uint32(a.cm&15)<<3|uint32(a.op2)&7)
+		return tok(TokenImmediate, fmt.Sprintf("$%d", uint32(a.op0&1)<<14|uint32(a.op1&7)<<11|uint32(a.cn&15)<<7|uint32(a.cm&15)<<3|uint32(a.op2)&7))
 
 	case Imm_prfop:
 		if strings.Contains(a.String(), "#") {
-			return fmt.Sprintf("$%d", a)
+			return tok(TokenImmediate, fmt.Sprintf("$%d", a))
 		}
 	case sysOp:
-		result := a.op.String()
+		ts := tok(TokenText, a.op.String())
 		if a.r != 0 {
-			result += ", " + plan9gpr(a.r)
+			ts.text(", ")
+			ts.add(TokenRegister, plan9gpr(a.r))
 		}
-		return result
+		return ts
 	}
 
-	return strings.ToUpper(arg.String())
+	return tok(TokenText, strings.ToUpper(arg.String()))
+}
+
+// plan9Base returns the tokens of the base register (Rn) of a memory
+// reference.
+func plan9Base(base RegSP) tokens {
+	ts := tok(TokenText, "(")
+	if regno := uint16(base) & 31; regno == 31 {
+		ts.add(TokenRegister, "RSP")
+	} else {
+		ts.add(TokenRegister, fmt.Sprintf("R%d", regno))
+	}
+	ts.text(")")
+	return ts
 }
 
 // Convert a general-purpose register to plan9 assembly format.
@@ -0,0 +1,314 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// A TLSModel is a thread-local storage access model,
+// as defined by the ELF TLS ABI for AArch64.
+type TLSModel uint8
+
+const (
+	_ TLSModel = iota
+
+	// TLSDesc is the TLS descriptor form of the general dynamic
+	// model. The code calls the resolver stored in a GOT descriptor,
+	// which returns the variable's offset from the thread pointer:
+	//
+	//	adrp x0, :tlsdesc:v
+	//	ldr  x1, [x0, #:tlsdesc_lo12:v]
+	//	add  x0, x0, #:tlsdesc_lo12:v
+	//	blr  x1
+	TLSDesc
+
+	// TLSInitialExec loads the variable's offset from the thread
+	// pointer from a GOT entry and adds the thread pointer:
+	//
+	//	adrp x0, :gottprel:v
+	//	ldr  x0, [x0, #:gottprel_lo12:v]
+	//	mrs  x1, tpidr_el0
+	//	add  x0, x0, x1
+	TLSInitialExec
+
+	// TLSLocalExec adds an offset fixed at link time to the thread
+	// pointer, as immediates or as a constant built in a register:
+	//
+	//	mrs  x0, tpidr_el0
+	//	add  x0, x0, #:tprel_hi12:v, lsl #12
+	//	add  x0, x0, #:tprel_lo12_nc:v
+	TLSLocalExec
+)
+
+var tlsModelNames = [...]string{
+	TLSDesc:        "TLSDesc",
+	TLSInitialExec: "TLSInitialExec",
+	TLSLocalExec:   "TLSLocalExec",
+}
+
+func (m TLSModel) String() string {
+	if 0 < m && int(m) < len(tlsModelNames) {
+		return tlsModelNames[m]
+	}
+	return fmt.Sprintf("TLSModel(%d)", int(m))
+}
+
+// A TLSAccess is an instruction sequence that computes the address
+// of a thread-local variable.
+type TLSAccess struct {
+	Model TLSModel
+
+	// Start and End are the addresses of the first instruction of the
+	// sequence and of the word after its last. The sequence may be
+	// interleaved with unrelated instructions.
+	Start, End uint64
+
+	// GOT is the address of the TLS descriptor (TLSDesc) or of the
+	// GOT entry holding the offset (TLSInitialExec).
+	GOT uint64
+
+	// Offset is the offset from the thread pointer (TLSLocalExec).
+	Offset uint64
+}
+
+func (a TLSAccess) String() string {
+	switch a.Model {
+	case TLSDesc, TLSInitialExec:
+		return fmt.Sprintf("%#x-%#x: %v, GOT %#x", a.Start, a.End, a.Model, a.GOT)
+	}
+	return fmt.Sprintf("%#x-%#x: %v, offset %#x", a.Start, a.End, a.Model, a.Offset)
+}
+
+// tlsWindow is the number of instructions, before or after the GOT
+// load or thread pointer read, that FindTLS searches for the rest of
+// a sequence, allowing for the compiler scheduling other instructions
+// in between.
+const tlsWindow = 8
+
+// FindTLS returns the thread-local storage accesses in code, which
+// holds instructions starting at address pc, in address order.
+// It recognizes the sequences the ELF TLS ABI specifies for the
+// TLSDesc, TLSInitialExec and TLSLocalExec models, including the
+// sequences linkers produce when relaxing one model to another
+// (leaving NOPs in place of removed instructions), and the local-exec
+// sequences of the Go toolchain, which build the offset with MOVZ
+// and MOVK. It works from the instructions alone, without relocations,
+// so it can be used on linked executables as well as on objects.
+func FindTLS(code []byte, pc uint64) []TLSAccess {
+	n := len(code) / 4
+	w := make([]uint32, n)
+	for i := range w {
+		w[i] = binary.LittleEndian.Uint32(code[4*i:])
+	}
+	addr := func(i int) uint64 { return pc + 4*uint64(i) }
+	used := make([]bool, n)
+	var found []TLSAccess
+	add := func(a TLSAccess, idx ...int) {
+		lo, hi := idx[0], idx[0]
+		for _, i := range idx {
+			used[i] = true
+			if i < lo {
+				lo = i
+			}
+			if i > hi {
+				hi = i
+			}
+		}
+		a.Start, a.End = addr(lo), addr(hi+1)
+		found = append(found, a)
+	}
+	window := func(i int) (lo, hi int) {
+		lo, hi = i-tlsWindow, i+tlsWindow
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > n-1 {
+			hi = n - 1
+		}
+		return lo, hi
+	}
+
+	// GOT loads: TLS descriptor calls and initial-exec offsets.
+	for i := 0; i+1 < n; i++ {
+		page, rd, ok := tlsADRP(w[i], addr(i))
+		if !ok {
+			continue
+		}
+		rt, rn, off, ok := tlsLDR64(w[i+1])
+		if !ok || rn != rd {
+			continue
+		}
+		got := page + off
+		if i+3 < n {
+			ard, arn, aimm, ok := tlsADDImm(w[i+2])
+			brn, bok := tlsBLR(w[i+3])
+			if ok && ard == rd && arn == rd && aimm == off && bok && brn == rt {
+				add(TLSAccess{Model: TLSDesc, GOT: got}, i, i+1, i+2, i+3)
+				continue
+			}
+		}
+		lo, hi := window(i + 1)
+		for k := lo; k <= hi; k++ {
+			tp, ok := tlsMRS(w[k])
+			if !ok || used[k] {
+				continue
+			}
+			j := tlsAddTo(w, k, i+2, hi, rt, tp)
+			if j >= 0 {
+				add(TLSAccess{Model: TLSInitialExec, GOT: got}, i, i+1, k, j)
+				break
+			}
+		}
+	}
+
+	// Local-exec: the thread pointer plus immediates or a constant.
+	for k := 0; k < n; k++ {
+		tp, ok := tlsMRS(w[k])
+		if !ok || used[k] {
+			continue
+		}
+		lo, hi := window(k)
+
+		// Immediates: up to two ADDs to the thread pointer.
+		var off uint64
+		idx := []int{k}
+		cur := tp
+		for j := k + 1; j <= hi && len(idx) < 3; j++ {
+			rd, rn, imm, ok := tlsADDImm(w[j])
+			if ok && rn == cur {
+				off += imm
+				cur = rd
+				idx = append(idx, j)
+			}
+		}
+		if len(idx) > 1 {
+			add(TLSAccess{Model: TLSLocalExec, Offset: off}, idx...)
+			continue
+		}
+
+		// A constant in a register, built by MOVZ and MOVK.
+		for j := k + 1; j <= hi; j++ {
+			rd, rn, rm, ok := tlsADDReg(w[j])
+			if !ok || rd == 31 {
+				continue
+			}
+			r := rn
+			if rn == tp {
+				r = rm
+			} else if rm != tp {
+				continue
+			}
+			if off, idx, ok := tlsConst(w, j, lo, r); ok {
+				add(TLSAccess{Model: TLSLocalExec, Offset: off}, append(idx, k, j)...)
+				break
+			}
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].Start < found[j].Start })
+	return found
+}
+
+// tlsAddTo returns the index of the first ADD (register) in w[from:to+1]
+// that adds registers a and b, or -1. The ADD must come after w[after].
+func tlsAddTo(w []uint32, after, from, to int, a, b uint32) int {
+	if from <= after {
+		from = after + 1
+	}
+	for j := from; j <= to; j++ {
+		_, rn, rm, ok := tlsADDReg(w[j])
+		if ok && (rn == a && rm == b || rn == b && rm == a) {
+			return j
+		}
+	}
+	return -1
+}
+
+// tlsConst returns the 64-bit constant that the MOVZ and MOVK
+// instructions in w[lo:j] leave in register r at w[j], and their indexes.
+func tlsConst(w []uint32, j, lo int, r uint32) (uint64, []int, bool) {
+	var x, set uint64
+	var idx []int
+	for i := j - 1; i >= lo; i-- {
+		enc := w[i]
+		if enc&31 != r {
+			continue
+		}
+		shift := (enc >> 21 & 3) * 16
+		imm := uint64(enc>>5&0xffff) << shift
+		mask := uint64(0xffff) << shift
+		switch enc & 0xff800000 {
+		case 0xf2800000: // MOVK (64-bit)
+			if set&mask == 0 {
+				x |= imm
+				set |= mask
+			}
+			idx = append(idx, i)
+		case 0xd2800000: // MOVZ (64-bit)
+			x |= imm &^ set
+			return x, append(idx, i), true
+		default:
+			return 0, nil, false
+		}
+	}
+	return 0, nil, false
+}
+
+// The tls* decoders below work on the fields of 64-bit
+// instruction encodings; register 31 is SP or XZR.
+
+func tlsADRP(enc uint32, pc uint64) (page uint64, rd uint32, ok bool) {
+	if enc&0x9f000000 != 0x90000000 {
+		return 0, 0, false
+	}
+	imm := int64(int32((enc>>5&0x7ffff)<<13|(enc>>29&3)<<11) >> 11)
+	return pc&^0xfff + uint64(imm<<12), enc & 31, true
+}
+
+// tlsLDR64 decodes LDR (immediate, unsigned offset) of an X register.
+func tlsLDR64(enc uint32) (rt, rn uint32, off uint64, ok bool) {
+	if enc&0xffc00000 != 0xf9400000 {
+		return 0, 0, 0, false
+	}
+	return enc & 31, enc >> 5 & 31, uint64(enc>>10&0xfff) * 8, true
+}
+
+func tlsADDImm(enc uint32) (rd, rn uint32, imm uint64, ok bool) {
+	if enc&0xff800000 != 0x91000000 {
+		return 0, 0, 0, false
+	}
+	imm = uint64(enc >> 10 & 0xfff)
+	if enc&(1<<22) != 0 {
+		imm <<= 12
+	}
+	return enc & 31, enc >> 5 & 31, imm, true
+}
+
+// tlsADDReg decodes ADD (shifted register) with no shift
+// and ADD (extended register) with UXTX and no shift.
+func tlsADDReg(enc uint32) (rd, rn, rm uint32, ok bool) {
+	if enc&0xffe0fc00 != 0x8b000000 && enc&0xffe0fc00 != 0x8b206000 {
+		return 0, 0, 0, false
+	}
+	return enc & 31, enc >> 5 & 31, enc >> 16 & 31, true
+}
+
+func tlsBLR(enc uint32) (rn uint32, ok bool) {
+	if enc&0xfffffc1f != 0xd63f0000 {
+		return 0, false
+	}
+	return enc >> 5 & 31, true
+}
+
+// tlsMRS decodes MRS Xt, TPIDR_EL0, which reads the thread pointer.
+func tlsMRS(enc uint32) (rt uint32, ok bool) {
+	if enc&0xffffffe0 != 0xd53bd040 {
+		return 0, false
+	}
+	return enc & 31, true
+}
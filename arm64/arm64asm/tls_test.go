@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func TestFindTLS(t *testing.T) {
+	words := []uint32{
+		0x90000020, // adrp x0, .+0x4000
+		0xf9400801, // ldr x1, [x0,#16]
+		0x91004000, // add x0, x0, #0x10
+		0xd63f0020, // blr x1
+		0xd53bd042, // mrs x2, tpidr_el0
+		0x8b000040, // add x0, x2, x0
+
+		0x90000020, // adrp x0, .+0x4000
+		0xf9400800, // ldr x0, [x0,#16]
+		0xd503201f, // nop
+		0xd53bd041, // mrs x1, tpidr_el0
+		0x8b010000, // add x0, x0, x1
+
+		0xd53bd040, // mrs x0, tpidr_el0
+		0x91400400, // add x0, x0, #0x1, lsl #12
+		0x91004000, // add x0, x0, #0x10
+
+		0xd2a00041, // mov x1, #0x20000
+		0xf2800201, // movk x1, #0x10
+		0xd53bd040, // mrs x0, tpidr_el0
+		0x8b010000, // add x0, x0, x1
+	}
+	code := make([]byte, 4*len(words))
+	for i, w := range words {
+		binary.LittleEndian.PutUint32(code[4*i:], w)
+	}
+	got := FindTLS(code, 0x10000)
+	want := []TLSAccess{
+		{Model: TLSDesc, Start: 0x10000, End: 0x10010, GOT: 0x14010},
+		{Model: TLSInitialExec, Start: 0x10018, End: 0x1002c, GOT: 0x14010},
+		{Model: TLSLocalExec, Start: 0x1002c, End: 0x10038, Offset: 0x1010},
+		{Model: TLSLocalExec, Start: 0x10038, End: 0x10048, Offset: 0x20010},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindTLS:\nhave %v\nwant %v", got, want)
+	}
+
+	// A GOT load whose result is not added to the thread pointer
+	// is an ordinary global variable access.
+	binary.LittleEndian.PutUint32(code[4*10:], 0xd503201f)
+	if got := FindTLS(code[4*6:4*11], 0x10018); len(got) != 0 {
+		t.Errorf("FindTLS of GOT load = %v, want none", got)
+	}
+}
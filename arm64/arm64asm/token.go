@@ -0,0 +1,201 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A TokenKind describes the role of a Token in formatted assembly text.
+type TokenKind uint8
+
+const (
+	TokenText      TokenKind = iota // punctuation, spacing, shift and extend names and other literal text
+	TokenMnemonic                   // instruction mnemonic, including any suffixes
+	TokenRegister                   // register name
+	TokenImmediate                  // immediate constant or memory offset
+	TokenAddress                    // code address, such as a branch target
+	TokenSymbol                     // symbol name
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case TokenText:
+		return "Text"
+	case TokenMnemonic:
+		return "Mnemonic"
+	case TokenRegister:
+		return "Register"
+	case TokenImmediate:
+		return "Immediate"
+	case TokenAddress:
+		return "Address"
+	case TokenSymbol:
+		return "Symbol"
+	}
+	return fmt.Sprintf("TokenKind(%d)", int(k))
+}
+
+// A Token is a piece of formatted assembly text.
+// Concatenating the Text of all the tokens emitted for an instruction
+// yields exactly the string returned by the corresponding syntax function.
+//
+// Arg is the index in Inst.Args of the argument the token was formatted
+// from, or -1 if the token is not part of any argument, as for the
+// mnemonic and the separators between arguments. Go syntax reorders
+// and sometimes merges arguments; tokens formatted from several
+// arguments at once, such as the system register number of SYSL,
+// also have Arg -1.
+type Token struct {
+	Kind TokenKind
+	Text string
+	Arg  int
+}
+
+// GNUSyntaxTokens is like GNUSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func GNUSyntaxTokens(inst Inst, emit func(Token)) {
+	var ts tokens
+	appendGNUSyntax(nil, inst, &ts)
+	ts.emit(emit)
+}
+
+// GoSyntaxTokens is like GoSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func GoSyntaxTokens(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt, emit func(Token)) {
+	goTokens(inst, pc, symname, text).emit(emit)
+}
+
+// A tokens is formatted assembly text, as a sequence of tokens.
+// GoSyntax formats instructions as tokens and returns their
+// concatenation. GNUSyntax appends to a byte slice to avoid
+// allocating and, given a non-nil *tokens, marks the tokens of
+// the text as it appends it.
+type tokens []Token
+
+// add appends s as a token of the given kind that is not part of any
+// argument. Adjacent text tokens of the same argument are merged.
+func (ts *tokens) add(kind TokenKind, s string) {
+	ts.addArg(kind, s, -1)
+}
+
+func (ts *tokens) addArg(kind TokenKind, s string, arg int) {
+	if s == "" {
+		return
+	}
+	if n := len(*ts); kind == TokenText && n > 0 {
+		if last := &(*ts)[n-1]; last.Kind == TokenText && last.Arg == arg {
+			last.Text += s
+			return
+		}
+	}
+	*ts = append(*ts, Token{kind, s, arg})
+}
+
+// mark adds b[start:], just appended by a printer, as a token of the
+// given kind attributed to argument arg. It does nothing if ts is nil,
+// without converting b.
+func (ts *tokens) mark(kind TokenKind, b []byte, start, arg int) {
+	if ts != nil {
+		ts.addArg(kind, string(b[start:]), arg)
+	}
+}
+
+// appendTok appends s to dst and marks it in ts as a token
+// of the given kind attributed to argument arg.
+func appendTok(dst []byte, ts *tokens, kind TokenKind, s string, arg int) []byte {
+	start := len(dst)
+	dst = append(dst, s...)
+	ts.mark(kind, dst, start, arg)
+	return dst
+}
+
+// mapText replaces the text of each token t in ts with f(t.Text).
+// It does nothing if ts is nil.
+func (ts *tokens) mapText(f func(string) string) {
+	if ts == nil {
+		return
+	}
+	for i := range *ts {
+		(*ts)[i].Text = f((*ts)[i].Text)
+	}
+}
+
+// trim removes the last n bytes of text from ts,
+// dropping the tokens that become empty.
+func (ts *tokens) trim(n int) {
+	for ts != nil && n > 0 && len(*ts) > 0 {
+		last := &(*ts)[len(*ts)-1]
+		if len(last.Text) > n {
+			last.Text = last.Text[:len(last.Text)-n]
+			return
+		}
+		n -= len(last.Text)
+		*ts = (*ts)[:len(*ts)-1]
+	}
+}
+
+func (ts *tokens) text(s string) {
+	ts.add(TokenText, s)
+}
+
+func (ts *tokens) addAll(us tokens) {
+	for _, t := range us {
+		ts.addArg(t.Kind, t.Text, t.Arg)
+	}
+}
+
+// arg returns ts with all tokens attributed to argument i.
+func (ts tokens) arg(i int) tokens {
+	for j := range ts {
+		ts[j].Arg = i
+	}
+	return ts
+}
+
+func (ts tokens) String() string {
+	var b strings.Builder
+	for _, t := range ts {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+func (ts tokens) emit(emit func(Token)) {
+	for _, t := range ts {
+		emit(t)
+	}
+}
+
+// tok returns a single token of the given kind.
+func tok(kind TokenKind, s string) tokens {
+	return tokens{{kind, s, -1}}
+}
+
+// regListTokens returns the tokens of the register r with arrangement
+// suffix a or, if cnt > 0, of the list of cnt consecutive registers
+// starting at r, in the form GoSyntax uses, which brackets lists
+// with [ ] and spells out every register.
+func regListTokens(r Reg, a string, cnt uint8) tokens {
+	var ts tokens
+	reg := func(r Reg) {
+		ts.add(TokenRegister, r.String())
+		ts.text(a)
+	}
+	if cnt > 0 {
+		ts.text("[")
+	}
+	reg(r)
+	for i := 1; i < int(cnt); i++ {
+		ts.text(", ")
+		reg(V0 + Reg((uint16(r)-uint16(V0)+uint16(i))&31))
+	}
+	if cnt > 0 {
+		ts.text("]")
+	}
+	return ts
+}
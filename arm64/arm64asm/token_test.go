@@ -0,0 +1,121 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func joinTokens(toks []Token) string {
+	var b strings.Builder
+	for _, t := range toks {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+func TestSyntaxTokens(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		if addr == 0x1040 {
+			return "runtime.foo", 0x1040
+		}
+		return "", 0
+	}
+	var tests = []struct {
+		enc    uint32
+		syntax string
+		want   []Token
+	}{
+		{0x8b020c20, "gnu", []Token{ // add x0, x1, x2, lsl #3
+			{TokenMnemonic, "add", -1}, {TokenText, " ", -1}, {TokenRegister, "x0", 0}, {TokenText, ", ", -1},
+			{TokenRegister, "x1", 1}, {TokenText, ", ", -1}, {TokenRegister, "x2", 2}, {TokenText, ", lsl ", 2},
+			{TokenImmediate, "#3", 2},
+		}},
+		{0x8b020c20, "plan9", []Token{
+			{TokenMnemonic, "ADD", -1}, {TokenText, " ", -1}, {TokenRegister, "R2", 2}, {TokenText, "<<", 2},
+			{TokenImmediate, "3", 2}, {TokenText, ", ", -1}, {TokenRegister, "R1", 1}, {TokenText, ", ", -1},
+			{TokenRegister, "R0", 0},
+		}},
+		{0xf9400820, "gnu", []Token{ // ldr x0, [x1,#16]
+			{TokenMnemonic, "ldr", -1}, {TokenText, " ", -1}, {TokenRegister, "x0", 0}, {TokenText, ", ", -1},
+			{TokenText, "[", 1}, {TokenRegister, "x1", 1}, {TokenText, ",", 1}, {TokenImmediate, "#16", 1},
+			{TokenText, "]", 1},
+		}},
+		{0xf9400820, "plan9", []Token{
+			{TokenMnemonic, "MOVD", -1}, {TokenText, " ", -1}, {TokenImmediate, "16", 1}, {TokenText, "(", 1},
+			{TokenRegister, "R1", 1}, {TokenText, ")", 1}, {TokenText, ", ", -1}, {TokenRegister, "R0", 0},
+		}},
+		{0x94000010, "gnu", []Token{ // bl .+0x40
+			{TokenMnemonic, "bl", -1}, {TokenText, " ", -1}, {TokenAddress, ".+0x40", 0},
+		}},
+		{0x94000010, "plan9", []Token{
+			{TokenMnemonic, "CALL", -1}, {TokenText, " ", -1}, {TokenSymbol, "runtime.foo", 0},
+			{TokenText, "(SB)", 0},
+		}},
+	}
+	for _, tt := range tests {
+		inst, err := Decode([]byte{byte(tt.enc), byte(tt.enc >> 8), byte(tt.enc >> 16), byte(tt.enc >> 24)})
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		var toks []Token
+		emit := func(t Token) { toks = append(toks, t) }
+		if tt.syntax == "gnu" {
+			GNUSyntaxTokens(inst, emit)
+		} else {
+			GoSyntaxTokens(inst, 0x1000, symname, nil, emit)
+		}
+		if !reflect.DeepEqual(toks, tt.want) {
+			t.Errorf("%#08x [%s] tokens = %v, want %v", tt.enc, tt.syntax, toks, tt.want)
+		}
+	}
+}
+
+// TestGNUSyntaxTokensCorpus checks that the tokens of every instruction
+// in the test corpus concatenate to its GNU syntax, and that each token
+// belongs to no argument or to one the instruction has.
+func TestGNUSyntaxTokensCorpus(t *testing.T) {
+	check := func(enc []byte) {
+		inst, err := Decode(enc)
+		if err != nil {
+			return
+		}
+		var toks []Token
+		GNUSyntaxTokens(inst, func(t Token) { toks = append(toks, t) })
+		if s, want := joinTokens(toks), GNUSyntax(inst); s != want {
+			t.Errorf("% x: tokens = %q, want %q", enc, s, want)
+		}
+		for _, tok := range toks {
+			if tok.Arg < -1 || tok.Arg >= len(inst.Args) || tok.Arg >= 0 && inst.Args[tok.Arg] == nil {
+				t.Errorf("% x: token %q has Arg %d", enc, tok.Text, tok.Arg)
+			}
+		}
+	}
+	for _, syntax := range []string{"gnu", "plan9"} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", syntax+"cases.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			f := strings.Fields(line)
+			if len(f) == 0 || strings.HasPrefix(f[0], "#") {
+				continue
+			}
+			enc, err := hex.DecodeString(strings.Replace(f[0], "|", "", 1))
+			if err != nil {
+				t.Fatalf("parsing %q: %v", f[0], err)
+			}
+			check(enc)
+		}
+	}
+	hexCases(t, objdumpManualTests)(check)
+	JSONCases(t)(check)
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "fmt"
+
+// An UnpredictableReason classifies an encoding whose behavior
+// the Arm architecture does not fully define.
+type UnpredictableReason uint8
+
+const (
+	_ UnpredictableReason = iota
+
+	// UnpredictableWriteback is a load or store with base register
+	// writeback whose base register is also a transfer register.
+	UnpredictableWriteback
+
+	// UnpredictableSamePair is a load pair or load exclusive pair
+	// that loads both values into the same register.
+	UnpredictableSamePair
+
+	// UnpredictableStatus is a store exclusive whose status register
+	// is also a data register or the base register.
+	UnpredictableStatus
+)
+
+var unpredictableNames = [...]string{
+	UnpredictableWriteback: "UnpredictableWriteback",
+	UnpredictableSamePair:  "UnpredictableSamePair",
+	UnpredictableStatus:    "UnpredictableStatus",
+}
+
+func (r UnpredictableReason) String() string {
+	if 0 < r && int(r) < len(unpredictableNames) {
+		return unpredictableNames[r]
+	}
+	return fmt.Sprintf("UnpredictableReason(%d)", int(r))
+}
+
+// An Unpredictable describes why the behavior of an instruction is
+// CONSTRAINED UNPREDICTABLE: the Arm Architecture Reference Manual
+// allows each implementation to choose among several behaviors,
+// such as raising an exception or leaving a register UNKNOWN.
+type Unpredictable struct {
+	Reason UnpredictableReason
+	Reg    int    // number of the register used in conflicting roles
+	Detail string // the roles, as field names of the encoding
+}
+
+func (u Unpredictable) String() string {
+	return fmt.Sprintf("%v: %s are both register %d", u.Reason, u.Detail, u.Reg)
+}
+
+// CheckUnpredictable returns the reasons, if any, that the behavior
+// of inst is CONSTRAINED UNPREDICTABLE. It checks the register
+// constraints of loads and stores: base register writeback, register
+// pairs and store exclusive status registers. Register 31, which
+// means SP when used as the base register, never conflicts with a
+// transfer register, which it would name as XZR or WZR.
+func CheckUnpredictable(inst Inst) []Unpredictable {
+	x := inst.Enc
+	rt, rn, rt2, rs := int(x&31), int(x>>5&31), int(x>>10&31), int(x>>16&31)
+	simd := x>>26&1 == 1 // SIMD&FP transfer registers do not overlap the base
+
+	var u []Unpredictable
+	add := func(reason UnpredictableReason, reg int, detail string) {
+		u = append(u, Unpredictable{reason, reg, detail})
+	}
+	wback := false
+	for _, a := range inst.Args {
+		if m, ok := a.(MemImmediate); ok && (m.Mode == AddrPreIndex || m.Mode == AddrPostIndex) {
+			wback = true
+		}
+	}
+
+	switch inst.Op {
+	case LDP, LDPSW, LDNP:
+		if rt == rt2 {
+			add(UnpredictableSamePair, rt, "Rt and Rt2")
+		}
+		fallthrough
+	case STP, STNP:
+		if wback && !simd && rn != 31 {
+			if rt == rn {
+				add(UnpredictableWriteback, rn, "Rt and Rn")
+			}
+			if rt2 == rn {
+				add(UnpredictableWriteback, rn, "Rt2 and Rn")
+			}
+		}
+
+	case LDR, LDRB, LDRH, LDRSB, LDRSH, LDRSW, STR, STRB, STRH:
+		if wback && !simd && rn != 31 && rt == rn {
+			add(UnpredictableWriteback, rn, "Rt and Rn")
+		}
+
+	case LDXP, LDAXP:
+		if rt == rt2 {
+			add(UnpredictableSamePair, rt, "Rt and Rt2")
+		}
+
+	case STXP, STLXP:
+		if rs == rt2 {
+			add(UnpredictableStatus, rs, "Rs and Rt2")
+		}
+		fallthrough
+	case STXR, STXRB, STXRH, STLXR, STLXRB, STLXRH:
+		if rs == rt {
+			add(UnpredictableStatus, rs, "Rs and Rt")
+		}
+		if rs == rn && rn != 31 {
+			add(UnpredictableStatus, rs, "Rs and Rn")
+		}
+	}
+	return u
+}
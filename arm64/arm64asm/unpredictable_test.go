@@ -0,0 +1,46 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+func TestCheckUnpredictable(t *testing.T) {
+	tests := []struct {
+		enc  uint32
+		want string
+	}{
+		{0xa9400441, "[UnpredictableSamePair: Rt and Rt2 are both register 1]"}, // ldp x1, x1, [x2]
+		{0xa8c10821, "[UnpredictableWriteback: Rt and Rn are both register 1]"}, // ldp x1, x2, [x1],#16
+		{0xa9bf0be1, "[]"}, // stp x1, x2, [sp,#-16]!
+		{0xf8408400, "[UnpredictableWriteback: Rt and Rn are both register 0]"}, // ldr x0, [x0],#8
+		{0xf9400400, "[]"}, // ldr x0, [x0,#8]
+		{0x38401421, "[UnpredictableWriteback: Rt and Rn are both register 1]"}, // ldrb w1, [x1],#1
+		{0x38401420, "[]"}, // ldrb w0, [x1],#1
+		{0xc8007c20, "[UnpredictableStatus: Rs and Rt are both register 0]"}, // stxr w0, x0, [x1]
+		{0xc8017c20, "[UnpredictableStatus: Rs and Rn are both register 1]"}, // stxr w1, x0, [x1]
+		{0xc8027c20, "[]"}, // stxr w2, x0, [x1]
+		{0xc8200021, "[UnpredictableStatus: Rs and Rt2 are both register 0]"},   // stxp w0, x1, x0, [x1]
+		{0xc87f0020, "[UnpredictableSamePair: Rt and Rt2 are both register 0]"}, // ldxp x0, x0, [x1]
+		{0x6d400000, "[UnpredictableSamePair: Rt and Rt2 are both register 0]"}, // ldp d0, d0, [x0]
+		{0x6cc10400, "[]"}, // ldp d0, d1, [x0],#16
+		{0x8b020020, "[]"}, // add x0, x1, x2
+	}
+	var src [4]byte
+	for _, tt := range tests {
+		binary.LittleEndian.PutUint32(src[:], tt.enc)
+		inst, err := Decode(src[:])
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if got := fmt.Sprint(CheckUnpredictable(inst)); got != tt.want {
+			t.Errorf("CheckUnpredictable(%v) = %s, want %s", GNUSyntax(inst), got, tt.want)
+		}
+	}
+}
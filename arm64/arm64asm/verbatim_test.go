@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestGNUSyntaxVerbatim(t *testing.T) {
+	tests := []struct {
+		enc           uint32
+		gnu, verbatim string
+		canonicalized bool
+	}{
+		{0x8b020020, "add x0, x1, x2", "add x0, x1, x2, lsl #0", true},
+		{0x8b420c20, "add x0, x1, x2, lsr #3", "add x0, x1, x2, lsr #3", false},
+		{0x8b22603f, "add sp, x1, x2", "add sp, x1, x2, uxtx #0", true},
+		{0x0b214be0, "add w0, wsp, w1, lsl #2", "add w0, wsp, w1, uxtw #2", true},
+		{0x8b224020, "add x0, x1, w2, uxtw", "add x0, x1, w2, uxtw #0", false},
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:])
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		if s := GNUSyntax(inst); s != tt.gnu {
+			t.Errorf("GNUSyntax(%#08x) = %q, want %q", tt.enc, s, tt.gnu)
+		}
+		if s := GNUSyntaxVerbatim(inst); s != tt.verbatim {
+			t.Errorf("GNUSyntaxVerbatim(%#08x) = %q, want %q", tt.enc, s, tt.verbatim)
+		}
+		canon := false
+		for _, a := range inst.Args {
+			if rea, ok := a.(RegExtshiftAmount); ok && rea.Canonicalized() {
+				canon = true
+			}
+		}
+		if canon != tt.canonicalized {
+			t.Errorf("%#08x: Canonicalized() = %v, want %v", tt.enc, canon, tt.canonicalized)
+		}
+	}
+}
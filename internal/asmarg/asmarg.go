@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package asmarg defines the architecture-neutral description of
+// instruction arguments shared by the armasm, arm64asm, ppc64asm and
+// x86asm packages and by package listing.
+//
+// Each of those packages declares its ArgKind, RegRef and MemRef
+// types as aliases of the types here and its Kind constants as the
+// constants here, so that code handling several architectures can
+// compare and pass them around without conversion.
+package asmarg
+
+import "fmt"
+
+// A Kind classifies an instruction argument.
+type Kind uint8
+
+const (
+	Other   Kind = iota // none of the other kinds
+	Reg                 // register, possibly shifted or extended
+	Imm                 // immediate constant
+	Mem                 // memory reference
+	PCRel               // PC-relative address
+	Addr                // absolute code address
+	RegList             // register list
+	Cond                // condition
+	SysReg              // system or special-purpose register
+)
+
+var kindNames = [...]string{
+	Other:   "Other",
+	Reg:     "Reg",
+	Imm:     "Imm",
+	Mem:     "Mem",
+	PCRel:   "PCRel",
+	Addr:    "Addr",
+	RegList: "RegList",
+	Cond:    "Cond",
+	SysReg:  "SysReg",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("ArgKind(%d)", int(k))
+}
+
+// A RegRef describes a register.
+type RegRef struct {
+	Name string // as the package's register type formats it
+	Num  int    // number of the register in instruction encodings, or -1 if it has none
+}
+
+// A MemRef describes the address a memory reference accesses,
+// Segment:[Base + Index*Scale + Disp].
+type MemRef struct {
+	Segment string // segment override, or "" if none (x86 only)
+	Base    string // name of the base register, or "" if none
+	Index   string // name of the index register, or "" if none
+	Scale   int    // factor the index is multiplied by; 0 if there is no index
+	Disp    int64  // displacement
+}
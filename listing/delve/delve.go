@@ -0,0 +1,200 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package delve decodes instructions in the form that the Delve
+// debugger's disassemble command works with, so that Delve can use
+// one decoder for every architecture instead of glue code for each.
+//
+// AssemblyFlavour and AsmInstructionKind have the same names and
+// values as their counterparts in Delve's pkg/proc, and *Inst
+// implements the methods of its archInst interface.
+//
+// Dest reports only targets encoded in the instruction. Targets held
+// in registers or memory depend on the state of the stopped process,
+// which Delve resolves itself.
+package delve
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/ppc64/ppc64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// An AssemblyFlavour is an assembly syntax.
+type AssemblyFlavour int
+
+const (
+	GNUFlavour   AssemblyFlavour = iota // GNU (AT&T on x86)
+	IntelFlavour                        // Intel, for x86 only
+	GoFlavour                           // Go assembler
+)
+
+// An AsmInstructionKind classifies an instruction by its effect on
+// control flow.
+type AsmInstructionKind uint8
+
+const (
+	OtherInstruction AsmInstructionKind = iota
+	CallInstruction
+	RetInstruction
+	JmpInstruction // conditional or unconditional branch
+	HardBreakInstruction
+)
+
+// An Inst is a decoded instruction.
+type Inst struct {
+	Size int                // length of the instruction in bytes
+	Kind AsmInstructionKind // effect on control flow
+
+	// Dest is the target of a call or branch whose target is
+	// encoded in the instruction, and 0 otherwise.
+	Dest uint64
+
+	inst interface{} // x86asm.Inst, arm64asm.Inst or ppc64asm.Inst
+	op   uint64
+}
+
+// Decode decodes the instruction at the start of mem, which is
+// located at address pc, for the given GOARCH: "386", "amd64",
+// "arm64", "ppc64" or "ppc64le".
+func Decode(arch string, mem []byte, pc uint64) (*Inst, error) {
+	switch arch {
+	case "386", "amd64":
+		mode := 32
+		if arch == "amd64" {
+			mode = 64
+		}
+		inst, err := x86asm.Decode(mem, mode)
+		if err != nil {
+			return nil, err
+		}
+		i := &Inst{Size: inst.Len, inst: inst, op: uint64(inst.Op)}
+		i.Kind = x86Kind(inst)
+		if rel, ok := inst.Args[0].(x86asm.Rel); ok && i.Kind != OtherInstruction {
+			i.Dest = uint64(int64(pc) + int64(inst.Len) + int64(rel))
+		}
+		return i, nil
+
+	case "arm64":
+		inst, err := arm64asm.Decode(mem)
+		if err != nil {
+			return nil, err
+		}
+		i := &Inst{Size: 4, inst: inst, op: uint64(inst.Op)}
+		i.Kind = arm64Kind(inst)
+		for _, a := range inst.Args {
+			if rel, ok := a.(arm64asm.PCRel); ok && i.Kind != OtherInstruction {
+				i.Dest = uint64(int64(pc) + int64(rel))
+			}
+		}
+		return i, nil
+
+	case "ppc64", "ppc64le":
+		var ord binary.ByteOrder = binary.BigEndian
+		if arch == "ppc64le" {
+			ord = binary.LittleEndian
+		}
+		inst, err := ppc64asm.Decode(mem, ord)
+		if err != nil {
+			return nil, err
+		}
+		i := &Inst{Size: inst.Len, inst: inst, op: uint64(inst.Op)}
+		i.Kind = ppc64Kind(inst)
+		for _, a := range inst.Args {
+			switch a := a.(type) {
+			case ppc64asm.PCRel:
+				i.Dest = uint64(int64(pc) + int64(a))
+			case ppc64asm.Label:
+				i.Dest = uint64(a)
+			}
+		}
+		return i, nil
+	}
+	return nil, fmt.Errorf("delve: unsupported architecture %q", arch)
+}
+
+// Text returns the instruction, located at pc, in the given syntax.
+// symLookup, which may be nil, returns the symbol containing an
+// address and the symbol's base address, or "", 0 if there is none.
+func (i *Inst) Text(flavour AssemblyFlavour, pc uint64, symLookup func(uint64) (string, uint64)) string {
+	switch inst := i.inst.(type) {
+	case x86asm.Inst:
+		switch flavour {
+		case IntelFlavour:
+			return x86asm.IntelSyntax(inst, pc, symLookup)
+		case GoFlavour:
+			return x86asm.GoSyntax(inst, pc, symLookup)
+		}
+		return x86asm.GNUSyntax(inst, pc, symLookup)
+	case arm64asm.Inst:
+		if flavour == GoFlavour {
+			return arm64asm.GoSyntax(inst, pc, symLookup, nil)
+		}
+		return arm64asm.GNUSyntax(inst)
+	case ppc64asm.Inst:
+		if flavour == GoFlavour {
+			return ppc64asm.GoSyntax(inst, pc, symLookup)
+		}
+		return ppc64asm.GNUSyntax(inst, pc)
+	}
+	return "?"
+}
+
+// OpcodeEquals reports whether the instruction's opcode is op,
+// the value of an Op constant of the architecture's package,
+// such as x86asm.CALL or arm64asm.BL.
+func (i *Inst) OpcodeEquals(op uint64) bool {
+	return i.op == op
+}
+
+func x86Kind(inst x86asm.Inst) AsmInstructionKind {
+	switch x86asm.FlowOf(inst.Op) {
+	case x86asm.FlowCall:
+		return CallInstruction
+	case x86asm.FlowReturn:
+		return RetInstruction
+	case x86asm.FlowJump, x86asm.FlowCondJump:
+		return JmpInstruction
+	case x86asm.FlowTrap:
+		if inst.Op == x86asm.INT && inst.Args[0] == x86asm.Imm(3) {
+			return HardBreakInstruction
+		}
+	}
+	return OtherInstruction
+}
+
+func arm64Kind(inst arm64asm.Inst) AsmInstructionKind {
+	switch inst.Op {
+	case arm64asm.BL, arm64asm.BLR:
+		return CallInstruction
+	case arm64asm.RET:
+		return RetInstruction
+	case arm64asm.B, arm64asm.BR, arm64asm.CBZ, arm64asm.CBNZ, arm64asm.TBZ, arm64asm.TBNZ:
+		return JmpInstruction
+	case arm64asm.BRK:
+		return HardBreakInstruction
+	}
+	return OtherInstruction
+}
+
+func ppc64Kind(inst ppc64asm.Inst) AsmInstructionKind {
+	switch inst.Op {
+	case ppc64asm.BL, ppc64asm.BLA, ppc64asm.BCL, ppc64asm.BCLA,
+		ppc64asm.BCLRL, ppc64asm.BCCTRL, ppc64asm.BCTARL:
+		return CallInstruction
+	case ppc64asm.BCLR:
+		return RetInstruction
+	case ppc64asm.B, ppc64asm.BA, ppc64asm.BC, ppc64asm.BCA,
+		ppc64asm.BCCTR, ppc64asm.BCTAR:
+		return JmpInstruction
+	case ppc64asm.TW, ppc64asm.TD:
+		if inst.Args[0] == ppc64asm.Imm(31) { // trap unconditionally
+			return HardBreakInstruction
+		}
+	}
+	return OtherInstruction
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delve
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+var decodeTests = []struct {
+	arch string
+	hex  string
+	pc   uint64
+	size int
+	kind AsmInstructionKind
+	dest uint64
+	gnu  string
+	gosx string
+}{
+	{"amd64", "e810000000", 0x1000, 5, CallInstruction, 0x1015, "callq 0x1015", "CALL 0x1015"},
+	{"amd64", "c3", 0x1000, 1, RetInstruction, 0, "retq", "RET"},
+	{"amd64", "74fe", 0x1000, 2, JmpInstruction, 0x1000, "je 0x1000", "JE 0x1000"},
+	{"amd64", "ffe0", 0x1000, 2, JmpInstruction, 0, "jmp *%rax", "JMP AX"},
+	{"amd64", "cc", 0x1000, 1, HardBreakInstruction, 0, "int3", "INT $0x3"},
+	{"amd64", "4889c8", 0x1000, 3, OtherInstruction, 0, "mov %rcx,%rax", "MOVQ CX, AX"},
+	{"arm64", "04000094", 0x1000, 4, CallInstruction, 0x1010, "bl .+0x10", "CALL 4(PC)"},
+	{"arm64", "c0035fd6", 0x1000, 4, RetInstruction, 0, "ret", "RET"},
+	{"arm64", "010000d4", 0x1000, 4, OtherInstruction, 0, "svc #0x0", "SVC $0"},
+	{"arm64", "000020d4", 0x1000, 4, HardBreakInstruction, 0, "brk #0x0", "BRK $0"},
+	{"ppc64le", "08000048", 0x1000, 4, JmpInstruction, 0x1008, "b 0x1008", ""},
+	{"ppc64le", "2000804e", 0x1000, 4, RetInstruction, 0, "blr", ""},
+	{"ppc64le", "0800e07f", 0x1000, 4, HardBreakInstruction, 0, "tw 31,r0,r0", ""},
+}
+
+func TestDecode(t *testing.T) {
+	for _, tt := range decodeTests {
+		mem, err := hex.DecodeString(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := Decode(tt.arch, mem, tt.pc)
+		if err != nil {
+			t.Errorf("%s %s: %v", tt.arch, tt.hex, err)
+			continue
+		}
+		if inst.Size != tt.size || inst.Kind != tt.kind || inst.Dest != tt.dest {
+			t.Errorf("%s %s: size, kind, dest = %d, %d, %#x, want %d, %d, %#x", tt.arch, tt.hex, inst.Size, inst.Kind, inst.Dest, tt.size, tt.kind, tt.dest)
+		}
+		if got := inst.Text(GNUFlavour, tt.pc, nil); got != tt.gnu {
+			t.Errorf("%s %s: GNU text = %q, want %q", tt.arch, tt.hex, got, tt.gnu)
+		}
+		if got := inst.Text(GoFlavour, tt.pc, nil); tt.gosx != "" && got != tt.gosx {
+			t.Errorf("%s %s: Go text = %q, want %q", tt.arch, tt.hex, got, tt.gosx)
+		}
+	}
+}
+
+func TestOpcodeEquals(t *testing.T) {
+	inst, err := Decode("amd64", []byte{0xc3}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !inst.OpcodeEquals(uint64(x86asm.RET)) || inst.OpcodeEquals(uint64(x86asm.CALL)) {
+		t.Errorf("OpcodeEquals wrong for ret")
+	}
+	inst, err = Decode("arm64", []byte{0xc0, 0x03, 0x5f, 0xd6}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !inst.OpcodeEquals(uint64(arm64asm.RET)) {
+		t.Errorf("OpcodeEquals(RET) = false for arm64 ret")
+	}
+}
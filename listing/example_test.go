@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listing_test
+
+import (
+	"log"
+	"os"
+
+	"golang.org/x/arch/listing"
+)
+
+func ExampleWriteText() {
+	code := []byte{
+		0x55,                         // push %rbp
+		0xe8, 0xfa, 0xff, 0xff, 0xff, // call main.f
+		0x5d, // pop %rbp
+		0xc3, // ret
+	}
+	cfg := listing.Config{
+		Arch: "amd64",
+		Symname: func(addr uint64) (string, uint64) {
+			if addr == 0x1000 {
+				return "main.f", 0x1000
+			}
+			return "", 0
+		},
+	}
+	lines, err := cfg.Lines(code, 0x1000)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := listing.WriteText(os.Stdout, lines); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// main.f:
+	//   0x1000  55              push %rbp
+	//   0x1001  e8 fa ff ff ff  callq main.f
+	//   0x1006  5d              pop %rbp
+	//   0x1007  c3              retq
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"golang.org/x/arch/arm/armasm"
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/ppc64/ppc64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// A Flow describes how an instruction can change the program counter.
+type Flow uint8
+
+const (
+	// FlowNext means that the instruction always continues
+	// with the next instruction.
+	FlowNext Flow = iota
+
+	// FlowBranch is a branch, call or return instruction,
+	// conditional or not.
+	FlowBranch
+
+	// FlowImplicit is an instruction that can change the program
+	// counter other than as a branch: one that writes the PC as a
+	// general register (arm), returns from an exception, makes a
+	// system call, or traps, as breakpoints and trap instructions do.
+	FlowImplicit
+)
+
+var flowNames = [...]string{
+	FlowNext:     "FlowNext",
+	FlowBranch:   "FlowBranch",
+	FlowImplicit: "FlowImplicit",
+}
+
+func (f Flow) String() string {
+	if int(f) < len(flowNames) {
+		return flowNames[f]
+	}
+	return fmt.Sprintf("Flow(%d)", int(f))
+}
+
+// ControlFlow decodes the instruction at the start of code for the
+// given GOARCH, as Config.Arch describes, and reports how it can
+// change the program counter and the length of the instruction.
+// Debuggers single-stepping or placing breakpoints after an
+// instruction need to treat FlowBranch and FlowImplicit alike.
+func ControlFlow(arch string, code []byte) (Flow, int, error) {
+	switch arch {
+	case "386", "amd64":
+		mode := 32
+		if arch == "amd64" {
+			mode = 64
+		}
+		inst, err := x86asm.Decode(code, mode)
+		if err == nil && inst.Op == 0 {
+			err = x86asm.ErrUnrecognized
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		return x86Flow(inst.Op), inst.Len, nil
+
+	case "arm":
+		inst, err := armasm.Decode(code, armasm.ModeARM)
+		if err != nil {
+			return 0, 0, err
+		}
+		return armFlow(inst), inst.Len, nil
+
+	case "arm64":
+		inst, err := arm64asm.Decode(code)
+		if err != nil {
+			return 0, 0, err
+		}
+		return arm64Flow(inst.Op), 4, nil
+
+	case "ppc64", "ppc64le":
+		var ord binary.ByteOrder = binary.BigEndian
+		if arch == "ppc64le" {
+			ord = binary.LittleEndian
+		}
+		inst, err := ppc64asm.Decode(code, ord)
+		if err != nil {
+			return 0, 0, err
+		}
+		return ppc64Flow(inst.Op), inst.Len, nil
+	}
+	return 0, 0, fmt.Errorf("listing: unsupported architecture %q", arch)
+}
+
+func x86Flow(op x86asm.Op) Flow {
+	switch x86asm.FlowOf(op) {
+	case x86asm.FlowCall, x86asm.FlowReturn, x86asm.FlowJump, x86asm.FlowCondJump:
+		return FlowBranch
+	case x86asm.FlowIntReturn, x86asm.FlowSyscall, x86asm.FlowTrap, x86asm.FlowAbort:
+		return FlowImplicit
+	}
+	return FlowNext
+}
+
+func armFlow(inst armasm.Inst) Flow {
+	// Ops are named like "ADD.EQ"; consider only the operation.
+	name := inst.Op.String()
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	switch name {
+	case "B", "BL", "BLX", "BX":
+		return FlowBranch
+	case "SVC", "BKPT":
+		return FlowImplicit
+	case "LDM", "LDMDA", "LDMDB", "LDMIB", "POP":
+		for _, a := range inst.Args {
+			if l, ok := a.(armasm.RegList); ok && l&(1<<15) != 0 {
+				return FlowImplicit
+			}
+		}
+		if inst.Args[0] == armasm.PC { // POP with one register
+			return FlowImplicit
+		}
+	case "ADC", "ADD", "AND", "ASR", "BIC", "EOR", "LSL", "LSR", "MOV", "MVN",
+		"ORR", "ROR", "RRX", "RSB", "RSC", "SBC", "SUB", "LDR":
+		if inst.Args[0] == armasm.PC {
+			return FlowImplicit
+		}
+	}
+	return FlowNext
+}
+
+func arm64Flow(op arm64asm.Op) Flow {
+	switch op {
+	case arm64asm.B, arm64asm.BL, arm64asm.BR, arm64asm.BLR, arm64asm.RET,
+		arm64asm.CBZ, arm64asm.CBNZ, arm64asm.TBZ, arm64asm.TBNZ:
+		return FlowBranch
+	case arm64asm.ERET, arm64asm.DRPS, arm64asm.SVC, arm64asm.HVC, arm64asm.SMC,
+		arm64asm.BRK, arm64asm.HLT, arm64asm.DCPS1, arm64asm.DCPS2, arm64asm.DCPS3:
+		return FlowImplicit
+	}
+	return FlowNext
+}
+
+func ppc64Flow(op ppc64asm.Op) Flow {
+	switch op {
+	case ppc64asm.B, ppc64asm.BA, ppc64asm.BL, ppc64asm.BLA,
+		ppc64asm.BC, ppc64asm.BCA, ppc64asm.BCL, ppc64asm.BCLA,
+		ppc64asm.BCLR, ppc64asm.BCLRL, ppc64asm.BCCTR, ppc64asm.BCCTRL,
+		ppc64asm.BCTAR, ppc64asm.BCTARL:
+		return FlowBranch
+	case ppc64asm.SC, ppc64asm.SCV, ppc64asm.RFID, ppc64asm.HRFID, ppc64asm.URFID,
+		ppc64asm.RFEBB, ppc64asm.RFSCV, ppc64asm.TW, ppc64asm.TD, ppc64asm.TWI, ppc64asm.TDI:
+		return FlowImplicit
+	}
+	return FlowNext
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listing
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestControlFlow(t *testing.T) {
+	tests := []struct {
+		arch string
+		code string
+		flow Flow
+		len  int
+	}{
+		{"amd64", "4801d8", FlowNext, 3},         // add %rbx,%rax
+		{"amd64", "7400", FlowBranch, 2},         // je .+0
+		{"amd64", "ffd0", FlowBranch, 2},         // call *%rax
+		{"amd64", "c3", FlowBranch, 1},           // ret
+		{"amd64", "48cf", FlowImplicit, 2},       // iretq
+		{"amd64", "0f05", FlowImplicit, 2},       // syscall
+		{"amd64", "cc", FlowImplicit, 1},         // int3
+		{"amd64", "f1", FlowImplicit, 1},         // icebp (int1)
+		{"amd64", "f30f01ec", FlowImplicit, 4},   // uiret
+		{"386", "0f0b", FlowImplicit, 2},         // ud2
+		{"arm", "020081e0", FlowNext, 4},         // add r0, r1, r2
+		{"arm", "0ef0a0e1", FlowImplicit, 4},     // mov pc, lr
+		{"arm", "02f08fe0", FlowImplicit, 4},     // add pc, pc, r2
+		{"arm", "0080bde8", FlowImplicit, 4},     // pop {pc}
+		{"arm", "1080bde8", FlowImplicit, 4},     // pop {r4, pc}
+		{"arm", "04f09de4", FlowImplicit, 4},     // pop {pc} (ldr pc, [sp], #4)
+		{"arm", "1040bde8", FlowNext, 4},         // pop {r4, lr}
+		{"arm", "00f08de5", FlowNext, 4},         // str pc, [sp]
+		{"arm", "1eff2fe1", FlowBranch, 4},       // bx lr
+		{"arm", "0000000a", FlowBranch, 4},       // beq .+8
+		{"arm", "000000ef", FlowImplicit, 4},     // svc 0
+		{"arm64", "2000028b", FlowNext, 4},       // add x0, x1, x2
+		{"arm64", "c0035fd6", FlowBranch, 4},     // ret
+		{"arm64", "00000054", FlowBranch, 4},     // b.eq .
+		{"arm64", "e0039fd6", FlowImplicit, 4},   // eret
+		{"arm64", "010000d4", FlowImplicit, 4},   // svc #0
+		{"ppc64", "7c642a14", FlowNext, 4},       // add r3,r4,r5
+		{"ppc64", "4e800020", FlowBranch, 4},     // blr
+		{"ppc64", "44000002", FlowImplicit, 4},   // sc
+		{"ppc64le", "0800e07f", FlowImplicit, 4}, // trap
+		{"ppc64le", "2400004c", FlowImplicit, 4}, // rfid
+	}
+	for _, tt := range tests {
+		code, err := hex.DecodeString(tt.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		flow, n, err := ControlFlow(tt.arch, code)
+		if flow != tt.flow || n != tt.len || err != nil {
+			t.Errorf("ControlFlow(%s, %s) = %v, %d, %v, want %v, %d", tt.arch, tt.code, flow, n, err, tt.flow, tt.len)
+		}
+		if inst, err := Decode(tt.arch, code); err != nil || inst.Flow != flow || inst.Len != n {
+			t.Errorf("Decode(%s, %s) = Flow %v, Len %d, %v, want ControlFlow's %v, %d", tt.arch, tt.code, inst.Flow, inst.Len, err, flow, n)
+		}
+	}
+	if _, _, err := ControlFlow("mips", []byte{0, 0, 0, 0}); err == nil {
+		t.Errorf("ControlFlow(mips): no error")
+	}
+}
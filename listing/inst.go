@@ -0,0 +1,214 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listing
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/arch/arm/armasm"
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/internal/asmarg"
+	"golang.org/x/arch/ppc64/ppc64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// An Inst is a decoded instruction of any architecture.
+//
+// The Inst and Arg types of the disassembler packages cannot gain
+// fields or interface methods without breaking code that builds or
+// implements them, so instruction metadata that applies across
+// architectures is added here instead, as fields of Inst and Arg.
+// The conversion functions FromX86, FromARM, FromARM64 and FromPPC64
+// fill them in, and the Raw fields keep the package values for
+// anything this model does not cover.
+//
+// Converting back is done through Raw, which always holds the value
+// the Inst was made from; the X86, ARM, ARM64 and PPC64 methods are
+// shorthands for the type assertion. An Inst built by hand, without
+// Raw, has no package instruction to return.
+//
+// This model, rather than a v2 of the disassembler packages, is how
+// they grow: those packages add methods to their concrete Arg types
+// and functions that take an Inst, such as Kind and RegValue, and
+// keep their v1 types unchanged, so that existing code keeps
+// compiling. New fields appear here, never in the packages' Inst.
+type Inst struct {
+	Arch string // GOARCH, as in Config.Arch
+	Op   string // operation, as the package's Op.String formats it
+	Len  int    // length of the encoding in bytes
+	Args []Arg
+	Flow Flow // how the instruction can change the program counter
+
+	// Raw is the package instruction: an x86asm.Inst, armasm.Inst,
+	// arm64asm.Inst or ppc64asm.Inst.
+	Raw interface{}
+}
+
+// An ArgKind classifies an instruction argument.
+// It is the ArgKind type of each of the disassembler packages.
+type ArgKind = asmarg.Kind
+
+const (
+	KindOther   = asmarg.Other   // none of the other kinds
+	KindReg     = asmarg.Reg     // register, possibly shifted or extended
+	KindImm     = asmarg.Imm     // immediate constant
+	KindMem     = asmarg.Mem     // memory reference
+	KindPCRel   = asmarg.PCRel   // PC-relative address
+	KindAddr    = asmarg.Addr    // absolute code address
+	KindRegList = asmarg.RegList // register list
+	KindCond    = asmarg.Cond    // condition
+	KindSysReg  = asmarg.SysReg  // system or special-purpose register
+)
+
+// An Arg is an instruction argument.
+type Arg struct {
+	Kind ArgKind
+	Text string // as the package's Arg.String formats it
+
+	// Imm is the value of an integer immediate,
+	// as the package's ImmValue returns it.
+	Imm    int64
+	HasImm bool
+
+	// Raw is the package argument: an x86asm.Arg, armasm.Arg,
+	// arm64asm.Arg or ppc64asm.Arg.
+	Raw interface{}
+}
+
+func (a Arg) String() string {
+	return a.Text
+}
+
+// X86 returns the x86asm instruction that in was converted from.
+// It returns false if in was not made by FromX86.
+func (in Inst) X86() (x86asm.Inst, bool) {
+	inst, ok := in.Raw.(x86asm.Inst)
+	return inst, ok
+}
+
+// ARM returns the armasm instruction that in was converted from.
+// It returns false if in was not made by FromARM.
+func (in Inst) ARM() (armasm.Inst, bool) {
+	inst, ok := in.Raw.(armasm.Inst)
+	return inst, ok
+}
+
+// ARM64 returns the arm64asm instruction that in was converted from.
+// It returns false if in was not made by FromARM64.
+func (in Inst) ARM64() (arm64asm.Inst, bool) {
+	inst, ok := in.Raw.(arm64asm.Inst)
+	return inst, ok
+}
+
+// PPC64 returns the ppc64asm instruction that in was converted from.
+// It returns false if in was not made by FromPPC64.
+func (in Inst) PPC64() (ppc64asm.Inst, bool) {
+	inst, ok := in.Raw.(ppc64asm.Inst)
+	return inst, ok
+}
+
+// Decode decodes the instruction at the start of code
+// for the given GOARCH, as Config.Arch describes.
+func Decode(arch string, code []byte) (Inst, error) {
+	switch arch {
+	case "386", "amd64":
+		mode := 32
+		if arch == "amd64" {
+			mode = 64
+		}
+		inst, err := x86asm.Decode(code, mode)
+		if err == nil && inst.Op == 0 {
+			err = x86asm.ErrUnrecognized
+		}
+		if err != nil {
+			return Inst{}, err
+		}
+		return FromX86(inst, mode), nil
+
+	case "arm":
+		inst, err := armasm.Decode(code, armasm.ModeARM)
+		if err != nil {
+			return Inst{}, err
+		}
+		return FromARM(inst), nil
+
+	case "arm64":
+		inst, err := arm64asm.Decode(code)
+		if err != nil {
+			return Inst{}, err
+		}
+		return FromARM64(inst), nil
+
+	case "ppc64", "ppc64le":
+		var ord binary.ByteOrder = binary.BigEndian
+		if arch == "ppc64le" {
+			ord = binary.LittleEndian
+		}
+		inst, err := ppc64asm.Decode(code, ord)
+		if err != nil {
+			return Inst{}, err
+		}
+		return FromPPC64(inst, arch), nil
+	}
+	return Inst{}, fmt.Errorf("listing: unsupported architecture %q", arch)
+}
+
+// FromX86 converts inst, decoded in the given processor mode
+// (16, 32 or 64), to an Inst. The Arch of 16- and 32-bit code is "386".
+func FromX86(inst x86asm.Inst, mode int) Inst {
+	in := Inst{Arch: "386", Op: inst.Op.String(), Len: inst.Len, Flow: x86Flow(inst.Op), Raw: inst}
+	if mode == 64 {
+		in.Arch = "amd64"
+	}
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		v, ok := x86asm.ImmValue(a)
+		in.Args = append(in.Args, Arg{x86asm.ArgKindOf(a), a.String(), v, ok, a})
+	}
+	return in
+}
+
+// FromARM converts inst, decoded in ARM mode, to an Inst.
+func FromARM(inst armasm.Inst) Inst {
+	in := Inst{Arch: "arm", Op: inst.Op.String(), Len: inst.Len, Flow: armFlow(inst), Raw: inst}
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		v, ok := armasm.ImmValue(a)
+		in.Args = append(in.Args, Arg{armasm.ArgKindOf(a), a.String(), v, ok, a})
+	}
+	return in
+}
+
+// FromARM64 converts inst to an Inst.
+func FromARM64(inst arm64asm.Inst) Inst {
+	in := Inst{Arch: "arm64", Op: inst.Op.String(), Len: 4, Flow: arm64Flow(inst.Op), Raw: inst}
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		v, ok := arm64asm.ImmValue(a)
+		in.Args = append(in.Args, Arg{arm64asm.ArgKindOf(a), a.String(), v, ok, a})
+	}
+	return in
+}
+
+// FromPPC64 converts inst to an Inst
+// with the given Arch, "ppc64" or "ppc64le".
+func FromPPC64(inst ppc64asm.Inst, arch string) Inst {
+	in := Inst{Arch: arch, Op: inst.Op.String(), Len: inst.Len, Flow: ppc64Flow(inst.Op), Raw: inst}
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		v, ok := ppc64asm.ImmValue(a)
+		in.Args = append(in.Args, Arg{ppc64asm.ArgKindOf(a), a.String(), v, ok, a})
+	}
+	return in
+}
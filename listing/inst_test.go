@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listing
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/arch/arm64/arm64asm"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		arch  string
+		code  string
+		op    string
+		len   int
+		kinds string
+		flow  Flow
+	}{
+		{"amd64", "\x48\x83\xc0\x05", "ADD", 4, "Reg Imm=5", FlowNext},            // add rax, 5
+		{"386", "\xe8\x00\x00\x00\x00", "CALL", 5, "PCRel", FlowBranch},           // call .+5
+		{"arm", "\x10\x00\x81\xe2", "ADD", 4, "Reg Reg Imm=16", FlowNext},         // add r0, r1, #16
+		{"arm64", "\x20\x04\x40\x91", "ADD", 4, "Reg Reg Imm=4096", FlowNext},     // add x0, x1, #1, lsl #12
+		{"ppc64", "\x38\x63\x00\x05", "addi", 4, "Reg Reg Imm=5", FlowNext},       // addi r3,r3,5
+		{"ppc64le", "\x08\x00\x82\x41", "bc", 4, "Imm=12 Cond PCRel", FlowBranch}, // beq .+8
+	}
+	for _, tt := range tests {
+		inst, err := Decode(tt.arch, []byte(tt.code))
+		if err != nil {
+			t.Errorf("Decode(%s, % x): %v", tt.arch, tt.code, err)
+			continue
+		}
+		var kinds []string
+		for _, a := range inst.Args {
+			s := a.Kind.String()
+			if a.HasImm {
+				s += "=" + strconv.FormatInt(a.Imm, 10)
+			}
+			kinds = append(kinds, s)
+		}
+		k := strings.Join(kinds, " ")
+		if inst.Arch != tt.arch || inst.Op != tt.op || inst.Len != tt.len || k != tt.kinds || inst.Flow != tt.flow {
+			t.Errorf("Decode(%s, % x) = %s %s %d %q %v, want %s %s %d %q %v",
+				tt.arch, tt.code, inst.Arch, inst.Op, inst.Len, k, inst.Flow,
+				tt.arch, tt.op, tt.len, tt.kinds, tt.flow)
+		}
+	}
+
+	if _, err := Decode("mips", []byte{0, 0, 0, 0}); err == nil {
+		t.Errorf("Decode(mips) succeeded")
+	}
+}
+
+func TestFromRaw(t *testing.T) {
+	inst, err := Decode("arm64", []byte{0x20, 0x04, 0x40, 0x91})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, ok := inst.ARM64()
+	if !ok || raw.Op != arm64asm.ADD {
+		t.Fatalf("ARM64() = %#v, %v, want arm64asm.Inst for ADD", raw, ok)
+	}
+	if _, ok := inst.X86(); ok {
+		t.Errorf("X86() succeeded for an arm64 Inst")
+	}
+	if got := FromARM64(raw); got.Op != inst.Op || len(got.Args) != len(inst.Args) {
+		t.Errorf("FromARM64(Raw) = %+v, want %+v", got, inst)
+	}
+	if _, ok := inst.Args[2].Raw.(arm64asm.ImmShift); !ok {
+		t.Errorf("Args[2].Raw = %#v, want arm64asm.ImmShift", inst.Args[2].Raw)
+	}
+	if k := arm64asm.ArgKindOf(raw.Args[2]); inst.Args[2].Kind != k {
+		t.Errorf("Args[2].Kind = %v, want %v", inst.Args[2].Kind, k)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package objfile reads the code, function symbols and line tables
+// of ELF and Mach-O executables for the listing packages.
+package objfile
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"debug/macho"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// A File is an open executable.
+type File struct {
+	Arch string // GOARCH of the code
+	Syms []Sym  // function symbols, sorted by address
+
+	secs  []section // executable sections
+	dwarf *dwarf.Data
+	close func() error
+}
+
+// A Sym is a function symbol.
+type Sym struct {
+	Name       string
+	Addr, Size uint64
+}
+
+type section struct {
+	addr, size uint64
+	r          io.ReaderAt
+}
+
+// Open opens the named ELF or Mach-O executable.
+func Open(file string) (*File, error) {
+	if f, err := elf.Open(file); err == nil {
+		return elfFile(f)
+	}
+	if f, err := macho.Open(file); err == nil {
+		return machoFile(f)
+	}
+	return nil, fmt.Errorf("objfile: %s: not an ELF or Mach-O file", file)
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	return f.close()
+}
+
+func elfFile(f *elf.File) (*File, error) {
+	o := &File{close: f.Close}
+	switch f.Machine {
+	case elf.EM_386:
+		o.Arch = "386"
+	case elf.EM_X86_64:
+		o.Arch = "amd64"
+	case elf.EM_ARM:
+		o.Arch = "arm"
+	case elf.EM_AARCH64:
+		o.Arch = "arm64"
+	case elf.EM_PPC64:
+		o.Arch = "ppc64"
+		if f.ByteOrder == binary.LittleEndian {
+			o.Arch = "ppc64le"
+		}
+	default:
+		f.Close()
+		return nil, fmt.Errorf("objfile: unsupported ELF machine %v", f.Machine)
+	}
+	for _, s := range f.Sections {
+		if s.Type == elf.SHT_PROGBITS && s.Flags&elf.SHF_EXECINSTR != 0 {
+			o.secs = append(o.secs, section{s.Addr, s.Size, s})
+		}
+	}
+	syms, _ := f.Symbols() // a stripped binary has none
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC {
+			o.Syms = append(o.Syms, Sym{s.Name, s.Value, s.Size})
+		}
+	}
+	o.sortSyms()
+	o.dwarf, _ = f.DWARF()
+	return o, nil
+}
+
+func machoFile(f *macho.File) (*File, error) {
+	o := &File{close: f.Close}
+	switch f.Cpu {
+	case macho.Cpu386:
+		o.Arch = "386"
+	case macho.CpuAmd64:
+		o.Arch = "amd64"
+	case macho.CpuArm:
+		o.Arch = "arm"
+	case macho.CpuArm64:
+		o.Arch = "arm64"
+	case macho.CpuPpc64:
+		o.Arch = "ppc64"
+	default:
+		f.Close()
+		return nil, fmt.Errorf("objfile: unsupported Mach-O CPU %v", f.Cpu)
+	}
+	const attrPureInstructions = 0x80000000 // S_ATTR_PURE_INSTRUCTIONS
+	for _, s := range f.Sections {
+		if s.Flags&attrPureInstructions != 0 {
+			o.secs = append(o.secs, section{s.Addr, s.Size, s})
+		}
+	}
+	if f.Symtab != nil {
+		for _, s := range f.Symtab.Syms {
+			// Symbols in a section (N_SECT) that is executable.
+			if s.Type&0x0e == 0x0e && s.Sect > 0 && int(s.Sect) <= len(f.Sections) &&
+				f.Sections[s.Sect-1].Flags&attrPureInstructions != 0 {
+				o.Syms = append(o.Syms, Sym{s.Name, s.Value, 0})
+			}
+		}
+	}
+	o.sortSyms()
+	o.dwarf, _ = f.DWARF()
+	return o, nil
+}
+
+// sortSyms sorts the symbols by address and gives those with
+// no size one extending to the next symbol.
+func (f *File) sortSyms() {
+	sort.Slice(f.Syms, func(i, j int) bool { return f.Syms[i].Addr < f.Syms[j].Addr })
+	for i := range f.Syms {
+		if f.Syms[i].Size == 0 && i+1 < len(f.Syms) {
+			f.Syms[i].Size = f.Syms[i+1].Addr - f.Syms[i].Addr
+		}
+	}
+}
+
+// Text returns the contents of the executable section at [start, end).
+func (f *File) Text(start, end uint64) ([]byte, error) {
+	if end < start {
+		return nil, fmt.Errorf("objfile: invalid address range %#x-%#x", start, end)
+	}
+	for _, s := range f.secs {
+		if s.addr <= start && end <= s.addr+s.size {
+			code := make([]byte, end-start)
+			if _, err := s.r.ReadAt(code, int64(start-s.addr)); err != nil {
+				return nil, err
+			}
+			return code, nil
+		}
+	}
+	return nil, fmt.Errorf("objfile: no executable section contains %#x-%#x", start, end)
+}
+
+// Symname returns the name and address of the function containing addr.
+func (f *File) Symname(addr uint64) (string, uint64) {
+	i := sort.Search(len(f.Syms), func(i int) bool { return f.Syms[i].Addr > addr })
+	if i > 0 {
+		s := &f.Syms[i-1]
+		if addr < s.Addr+s.Size || s.Size == 0 {
+			return s.Name, s.Addr
+		}
+	}
+	return "", 0
+}
+
+// A PCLine records that the code from Addr up to the next PCLine
+// comes from the given source line.
+type PCLine struct {
+	Addr uint64
+	File string
+	Line int
+}
+
+// LineTable returns the DWARF line table entries for [start, end),
+// sorted by address. It returns nil if the file has no DWARF.
+func (f *File) LineTable(start, end uint64) []PCLine {
+	if f.dwarf == nil {
+		return nil
+	}
+	var pcs []PCLine
+	r := f.dwarf.Reader()
+	for {
+		cu, err := r.Next()
+		if err != nil || cu == nil {
+			break
+		}
+		if cu.Tag != dwarf.TagCompileUnit {
+			r.SkipChildren()
+			continue
+		}
+		lr, err := f.dwarf.LineReader(cu)
+		r.SkipChildren()
+		if err != nil || lr == nil {
+			continue
+		}
+		var e, prev dwarf.LineEntry
+		havePrev := false
+		for lr.Next(&e) == nil {
+			if havePrev && prev.Address < start && e.Address > start {
+				// prev covers start.
+				pcs = append(pcs, newPCLine(start, &prev))
+			}
+			if start <= e.Address && e.Address < end {
+				pcs = append(pcs, newPCLine(e.Address, &e))
+			}
+			prev, havePrev = e, !e.EndSequence
+		}
+	}
+	// Where one sequence ends as another begins, the beginning wins.
+	sort.SliceStable(pcs, func(i, j int) bool {
+		if pcs[i].Addr != pcs[j].Addr {
+			return pcs[i].Addr < pcs[j].Addr
+		}
+		return pcs[i].File == "" && pcs[j].File != ""
+	})
+	return pcs
+}
+
+func newPCLine(addr uint64, e *dwarf.LineEntry) PCLine {
+	if e.EndSequence || e.File == nil {
+		return PCLine{Addr: addr}
+	}
+	return PCLine{addr, e.File.Name, e.Line}
+}
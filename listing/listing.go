@@ -0,0 +1,328 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package listing produces objdump-style listings of machine code
+// using the disassemblers in this repository.
+//
+// A listing is a sequence of Lines, one per instruction, each giving
+// the address, the encoding, the formatted instruction split into
+// tokens, and any symbol or relocation annotations. Lines can be
+// written as plain text or as HTML.
+//
+// ControlFlow answers the same question about an instruction for
+// every architecture: whether and how it can change the program counter.
+//
+// Decode returns an Inst, a model of a decoded instruction shared by
+// all architectures, to which instruction metadata is added without
+// changing the types of the disassembler packages.
+package listing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/arch/arm/armasm"
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/ppc64/ppc64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// A TokenKind describes the role of a Token in a formatted instruction.
+type TokenKind uint8
+
+const (
+	Text      TokenKind = iota // punctuation, spacing and other literal text
+	Prefix                     // instruction prefix (x86 only)
+	Mnemonic                   // instruction mnemonic
+	Register                   // register name
+	Immediate                  // immediate constant or displacement
+	Address                    // code address, such as a branch target
+	Symbol                     // symbol name
+)
+
+var kindNames = [...]string{
+	Text:      "Text",
+	Prefix:    "Prefix",
+	Mnemonic:  "Mnemonic",
+	Register:  "Register",
+	Immediate: "Immediate",
+	Address:   "Address",
+	Symbol:    "Symbol",
+}
+
+func (k TokenKind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("TokenKind(%d)", int(k))
+}
+
+// x86Kind, armKind, arm64Kind and ppc64Kind convert the token kinds
+// of the architecture packages. Each handles every kind its package
+// defines and panics on any other, so that a kind added to a package
+// without a case here fails TestTokenKinds rather than turning into Text.
+
+func x86Kind(k x86asm.TokenKind) TokenKind {
+	switch k {
+	case x86asm.TokenText:
+		return Text
+	case x86asm.TokenPrefix:
+		return Prefix
+	case x86asm.TokenMnemonic:
+		return Mnemonic
+	case x86asm.TokenRegister:
+		return Register
+	case x86asm.TokenImmediate:
+		return Immediate
+	case x86asm.TokenAddress:
+		return Address
+	case x86asm.TokenSymbol:
+		return Symbol
+	}
+	panic("listing: unknown x86asm token kind " + k.String())
+}
+
+func armKind(k armasm.TokenKind) TokenKind {
+	switch k {
+	case armasm.TokenText:
+		return Text
+	case armasm.TokenMnemonic:
+		return Mnemonic
+	case armasm.TokenRegister:
+		return Register
+	case armasm.TokenImmediate:
+		return Immediate
+	case armasm.TokenAddress:
+		return Address
+	case armasm.TokenSymbol:
+		return Symbol
+	}
+	panic("listing: unknown armasm token kind " + k.String())
+}
+
+func arm64Kind(k arm64asm.TokenKind) TokenKind {
+	switch k {
+	case arm64asm.TokenText:
+		return Text
+	case arm64asm.TokenMnemonic:
+		return Mnemonic
+	case arm64asm.TokenRegister:
+		return Register
+	case arm64asm.TokenImmediate:
+		return Immediate
+	case arm64asm.TokenAddress:
+		return Address
+	case arm64asm.TokenSymbol:
+		return Symbol
+	}
+	panic("listing: unknown arm64asm token kind " + k.String())
+}
+
+func ppc64Kind(k ppc64asm.TokenKind) TokenKind {
+	switch k {
+	case ppc64asm.TokenText:
+		return Text
+	case ppc64asm.TokenMnemonic:
+		return Mnemonic
+	case ppc64asm.TokenRegister:
+		return Register
+	case ppc64asm.TokenImmediate:
+		return Immediate
+	case ppc64asm.TokenAddress:
+		return Address
+	case ppc64asm.TokenSymbol:
+		return Symbol
+	}
+	panic("listing: unknown ppc64asm token kind " + k.String())
+}
+
+// A Token is a piece of a formatted instruction.
+type Token struct {
+	Kind TokenKind
+	Text string
+}
+
+// A Line is one line of a listing, describing a single instruction.
+type Line struct {
+	Addr   uint64  // address of the instruction
+	Bytes  []byte  // encoding of the instruction
+	Tokens []Token // formatted instruction; nil if the bytes could not be decoded
+	Label  string  // name of the symbol starting at Addr, if any
+	Reloc  string  // relocations applied to Bytes, if any
+}
+
+// Asm returns the formatted instruction, or "?" if it could not be decoded.
+func (l *Line) Asm() string {
+	if l.Tokens == nil {
+		return "?"
+	}
+	var b strings.Builder
+	for _, t := range l.Tokens {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+// A Config describes how to disassemble and format machine code.
+type Config struct {
+	// Arch is the GOARCH of the code: "386", "amd64", "arm",
+	// "arm64", "ppc64" or "ppc64le".
+	Arch string
+
+	// Syntax is the assembly syntax: "gnu" (the default), "go",
+	// or, for 386 and amd64, "intel" or "masm".
+	Syntax string
+
+	// Symname, if not nil, returns the name and base address of
+	// the symbol containing addr, or "", 0 if there is none.
+	Symname func(addr uint64) (name string, base uint64)
+
+	// Reloc, if not nil, describes the relocations applied to the
+	// size bytes at addr, or returns "" if there are none.
+	Reloc func(addr uint64, size int) string
+
+	// Text, if not nil, reads the text segment using addresses as
+	// offsets. Go syntax uses it on arm and arm64 to show
+	// PC-relative loads as constant loads.
+	Text io.ReaderAt
+}
+
+// Lines disassembles code, which is located at address pc,
+// and returns one Line per instruction.
+// Bytes that cannot be decoded are listed as a single
+// instruction-sized unit (one byte on x86) with no tokens.
+func (c *Config) Lines(code []byte, pc uint64) ([]Line, error) {
+	decode, err := c.decoder()
+	if err != nil {
+		return nil, err
+	}
+	var lines []Line
+	for len(code) > 0 {
+		n, toks := decode(code, pc)
+		if n <= 0 || n > len(code) {
+			n = len(code)
+		}
+		l := Line{Addr: pc, Bytes: code[:n], Tokens: toks}
+		if c.Symname != nil {
+			if s, base := c.Symname(pc); s != "" && base == pc {
+				l.Label = s
+			}
+		}
+		if c.Reloc != nil {
+			l.Reloc = c.Reloc(pc, n)
+		}
+		lines = append(lines, l)
+		code = code[n:]
+		pc += uint64(n)
+	}
+	return lines, nil
+}
+
+// A decodeFunc decodes the instruction at the start of code, located
+// at pc, and returns its length and tokens. If the instruction cannot
+// be decoded, the tokens are nil and the length is the number of
+// bytes to skip.
+type decodeFunc func(code []byte, pc uint64) (int, []Token)
+
+func (c *Config) decoder() (decodeFunc, error) {
+	symname := c.Symname
+	if symname == nil {
+		symname = func(uint64) (string, uint64) { return "", 0 }
+	}
+	syntax := c.Syntax
+	if syntax == "" {
+		syntax = "gnu"
+	}
+	if syntax != "gnu" && syntax != "go" && (syntax != "intel" && syntax != "masm" || c.Arch != "386" && c.Arch != "amd64") {
+		return nil, fmt.Errorf("listing: unsupported syntax %q for %s", c.Syntax, c.Arch)
+	}
+
+	switch c.Arch {
+	case "386", "amd64":
+		mode := 32
+		if c.Arch == "amd64" {
+			mode = 64
+		}
+		return func(code []byte, pc uint64) (int, []Token) {
+			inst, err := x86asm.Decode(code, mode)
+			if err != nil || inst.Op == 0 {
+				return 1, nil
+			}
+			var toks tokens
+			switch syntax {
+			case "gnu":
+				x86asm.GNUSyntaxTokens(inst, pc, symname, func(t x86asm.Token) { toks.add(x86Kind(t.Kind), t.Text) })
+			case "intel":
+				x86asm.IntelSyntaxTokens(inst, pc, symname, func(t x86asm.Token) { toks.add(x86Kind(t.Kind), t.Text) })
+			case "masm":
+				x86asm.MASMSyntaxTokens(inst, pc, symname, func(t x86asm.Token) { toks.add(x86Kind(t.Kind), t.Text) })
+			case "go":
+				x86asm.GoSyntaxTokens(inst, pc, symname, func(t x86asm.Token) { toks.add(x86Kind(t.Kind), t.Text) })
+			}
+			return inst.Len, toks
+		}, nil
+
+	case "arm":
+		return func(code []byte, pc uint64) (int, []Token) {
+			inst, err := armasm.Decode(code, armasm.ModeARM)
+			if err != nil {
+				return 4, nil
+			}
+			var toks tokens
+			emit := func(t armasm.Token) { toks.add(armKind(t.Kind), t.Text) }
+			if syntax == "go" {
+				armasm.GoSyntaxTokens(inst, pc, symname, c.Text, emit)
+			} else {
+				armasm.GNUSyntaxTokens(inst, emit)
+			}
+			return inst.Len, toks
+		}, nil
+
+	case "arm64":
+		return func(code []byte, pc uint64) (int, []Token) {
+			inst, err := arm64asm.Decode(code)
+			if err != nil {
+				return 4, nil
+			}
+			var toks tokens
+			emit := func(t arm64asm.Token) { toks.add(arm64Kind(t.Kind), t.Text) }
+			if syntax == "go" {
+				arm64asm.GoSyntaxTokens(inst, pc, symname, c.Text, emit)
+			} else {
+				arm64asm.GNUSyntaxTokens(inst, emit)
+			}
+			return 4, toks
+		}, nil
+
+	case "ppc64", "ppc64le":
+		var ord binary.ByteOrder = binary.BigEndian
+		if c.Arch == "ppc64le" {
+			ord = binary.LittleEndian
+		}
+		return func(code []byte, pc uint64) (int, []Token) {
+			inst, err := ppc64asm.Decode(code, ord)
+			if err != nil {
+				return 4, nil
+			}
+			var toks tokens
+			emit := func(t ppc64asm.Token) { toks.add(ppc64Kind(t.Kind), t.Text) }
+			if syntax == "go" {
+				ppc64asm.GoSyntaxTokens(inst, pc, symname, emit)
+			} else {
+				ppc64asm.GNUSyntaxTokens(inst, pc, emit)
+			}
+			return inst.Len, toks
+		}, nil
+	}
+	return nil, fmt.Errorf("listing: unsupported architecture %q", c.Arch)
+}
+
+// tokens accumulates the tokens of an instruction.
+type tokens []Token
+
+func (t *tokens) add(kind TokenKind, text string) {
+	*t = append(*t, Token{kind, text})
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listing
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/arch/arm/armasm"
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/ppc64/ppc64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+func symname(addr uint64) (string, uint64) {
+	if 0x1000 <= addr && addr < 0x1010 {
+		return "main.f", 0x1000
+	}
+	return "", 0
+}
+
+func reloc(addr uint64, size int) string {
+	if addr <= 0x1005 && 0x1005 < addr+uint64(size) {
+		return "R_CALL main.g"
+	}
+	return ""
+}
+
+func TestWriteText(t *testing.T) {
+	code := []byte{
+		0x55,                         // push %rbp
+		0xe8, 0xfa, 0xff, 0xff, 0xff, // call main.f
+		0x0f, 0x0b, // ud2
+		0x0f, // truncated
+	}
+	c := &Config{Arch: "amd64", Syntax: "go", Symname: symname, Reloc: reloc}
+	lines, err := c.Lines(code, 0x1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := WriteText(&b, lines); err != nil {
+		t.Fatal(err)
+	}
+	want := `main.f:
+  0x1000  55              PUSHQ BP
+  0x1001  e8 fa ff ff ff  CALL main.f(SB)  // R_CALL main.g
+  0x1006  0f 0b           UD2
+  0x1008  0f              ?
+`
+	if b.String() != want {
+		t.Errorf("WriteText:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	c := &Config{Arch: "arm64", Symname: symname}
+	lines, err := c.Lines([]byte{0x20, 0x08, 0x40, 0xf9}, 0x1000) // ldr x0, [x1,#16]
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := WriteHTML(&b, lines); err != nil {
+		t.Fatal(err)
+	}
+	want := `<pre class="listing">
+<span class="label">main.f:</span>
+  0x1000  <span class="bytes">20 08 40 f9</span>  <span class="mnemonic">ldr</span> <span class="register">x0</span>, [<span class="register">x1</span>,<span class="immediate">#16</span>]
+</pre>
+`
+	if b.String() != want {
+		t.Errorf("WriteHTML:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	for _, c := range []Config{
+		{Arch: "mips"},
+		{Arch: "arm64", Syntax: "intel"},
+		{Arch: "ppc64", Syntax: "masm"},
+		{Arch: "amd64", Syntax: "att"},
+	} {
+		if _, err := c.Lines([]byte{0, 0, 0, 0}, 0); err == nil {
+			t.Errorf("%+v: Lines succeeded, want error", c)
+		}
+	}
+}
+
+func TestArchs(t *testing.T) {
+	var tests = []struct {
+		arch string
+		code []byte
+		want string
+	}{
+		{"386", []byte{0x89, 0xe5}, "mov %esp,%ebp"},
+		{"arm", []byte{0x00, 0x10, 0xa0, 0xe1}, "mov r1, r0"},
+		{"ppc64", []byte{0x7c, 0x08, 0x02, 0xa6}, "mflr r0"},
+		{"ppc64le", []byte{0xa6, 0x02, 0x08, 0x7c}, "mflr r0"},
+	}
+	for _, tt := range tests {
+		c := &Config{Arch: tt.arch}
+		lines, err := c.Lines(tt.code, 0)
+		if err != nil {
+			t.Errorf("%s: %v", tt.arch, err)
+			continue
+		}
+		if len(lines) != 1 || lines[0].Asm() != tt.want {
+			t.Errorf("%s: lines = %+v, want one line %q", tt.arch, lines, tt.want)
+		}
+	}
+}
+
+// TestTokenKinds checks that every token kind of the architecture
+// packages, up to the first one without a name, converts to the
+// TokenKind of the same name.
+func TestTokenKinds(t *testing.T) {
+	check := func(pkg, name string, conv func() TokenKind) bool {
+		if strings.HasPrefix(name, "TokenKind(") {
+			return false
+		}
+		defer func() {
+			if err := recover(); err != nil {
+				t.Errorf("%s %s: %v", pkg, name, err)
+			}
+		}()
+		if k := conv(); k.String() != name {
+			t.Errorf("%s %s converts to %v", pkg, name, k)
+		}
+		return true
+	}
+	for k := x86asm.TokenKind(0); check("x86asm", k.String(), func() TokenKind { return x86Kind(k) }); k++ {
+	}
+	for k := armasm.TokenKind(0); check("armasm", k.String(), func() TokenKind { return armKind(k) }); k++ {
+	}
+	for k := arm64asm.TokenKind(0); check("arm64asm", k.String(), func() TokenKind { return arm64Kind(k) }); k++ {
+	}
+	for k := ppc64asm.TokenKind(0); check("ppc64asm", k.String(), func() TokenKind { return ppc64Kind(k) }); k++ {
+	}
+}
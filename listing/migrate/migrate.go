@@ -0,0 +1,217 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package migrate reports the instructions that keep machine code
+// from running on a target processor baseline, such as a newer or
+// smaller CPU than the one the code was built for.
+//
+// It combines the processor metadata of the disassemblers: the
+// CPUID features of x86asm.Decoder, the ID registers of
+// arm64asm.Decoder, the processor generations of ppc64asm.Decoder
+// and the ARMv8 AArch32 deprecations of armasm.CheckDeprecated.
+// CheckFile reports the problems in an executable function by
+// function.
+package migrate
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/arch/arm/armasm"
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/listing/internal/objfile"
+	"golang.org/x/arch/ppc64/ppc64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// A Target describes a processor baseline. Only the field for the
+// architecture of the code is used; if it is unset, nothing is reported.
+type Target struct {
+	// X86 lists the features every 386 or amd64 target processor has.
+	// Instructions that x86.csv tags with no CPUID feature, the base
+	// integer and x87 instruction sets, are assumed to be present.
+	X86 *x86asm.FeatureSet
+
+	// ARM64 holds the ID registers of the arm64 target processor.
+	ARM64 *arm64asm.IDRegs
+
+	// PPC64 is the oldest ppc64 or ppc64le target processor.
+	PPC64 ppc64asm.CPU
+
+	// ARMv8 reports whether arm code must run in ARMv8 AArch32 state,
+	// which removes or deprecates some ARMv7 instructions.
+	ARMv8 bool
+}
+
+// A Problem is an instruction that the target may not execute.
+type Problem struct {
+	Addr   uint64 // address of the instruction
+	Inst   string // instruction, in GNU syntax
+	Reason string
+
+	// Deprecated reports whether the target can still execute the
+	// instruction, if system software allows it. If Deprecated is
+	// false, the target raises an undefined instruction exception.
+	Deprecated bool
+}
+
+func (p Problem) String() string {
+	s := fmt.Sprintf("%#x: %s: %s", p.Addr, p.Inst, p.Reason)
+	if p.Deprecated {
+		s += " (deprecated)"
+	}
+	return s
+}
+
+// Check returns the problems with running code, which holds
+// instructions for the given GOARCH starting at address pc,
+// on the target t.
+func Check(arch string, code []byte, pc uint64, t *Target) ([]Problem, error) {
+	var probs []Problem
+	switch arch {
+	case "386", "amd64":
+		if t.X86 == nil {
+			return nil, nil
+		}
+		// The Decoder with the target's features rewrites instructions
+		// that the target executes as something else; the one without
+		// decodes them as written, for comparison.
+		d := x86asm.Decoder{Mode: 32, Features: t.X86}
+		if arch == "amd64" {
+			d.Mode = 64
+		}
+		all := x86asm.Decoder{Mode: d.Mode}
+		var inst, want x86asm.Inst
+		for i := 0; i < len(code); {
+			addr := pc + uint64(i)
+			err := d.Decode(code[i:], &inst)
+			if err == x86asm.ErrUnsupported {
+				probs = append(probs, Problem{
+					Addr:   addr,
+					Inst:   x86asm.GNUSyntax(inst, addr, nil),
+					Reason: err.Error(),
+				})
+			} else if err == nil && inst.Op != x86asm.NOP && all.Decode(code[i:], &want) == nil && want.Op != inst.Op {
+				// Instructions such as ENDBR64 and RDSSPQ are designed
+				// to execute as NOPs on older processors; report only
+				// rewrites that change what the code does, such as
+				// LZCNT executing as BSR.
+				probs = append(probs, Problem{
+					Addr:   addr,
+					Inst:   x86asm.GNUSyntax(want, addr, nil),
+					Reason: "executed as " + x86asm.GNUSyntax(inst, addr, nil) + " by processor",
+				})
+			}
+			if err != nil && err != x86asm.ErrUnsupported || inst.Len == 0 {
+				i++
+				continue
+			}
+			i += inst.Len
+		}
+
+	case "arm":
+		if !t.ARMv8 {
+			return nil, nil
+		}
+		for _, u := range armasm.FindDeprecated(code, armasm.ModeARM, uint32(pc)) {
+			d := u.Deprecation
+			reason := "removed in ARMv8 AArch32"
+			if !d.Removed() {
+				reason = "deprecated in ARMv8 AArch32"
+				if c := d.Control(); c != "" {
+					reason += ", disabled by " + c
+				}
+			}
+			reason += "; use " + d.Replacement()
+			text := fmt.Sprintf(".word %#08x", u.Enc)
+			if inst, err := armasm.Decode(code[uint64(u.PC)-pc:], armasm.ModeARM); err == nil {
+				text = armasm.GNUSyntax(inst)
+			}
+			probs = append(probs, Problem{uint64(u.PC), text, reason, !d.Removed()})
+		}
+
+	case "arm64":
+		if t.ARM64 == nil {
+			return nil, nil
+		}
+		d := arm64asm.Decoder{IDRegs: t.ARM64}
+		var inst arm64asm.Inst
+		for i := 0; i+4 <= len(code); i += 4 {
+			if err := d.Decode(code[i:], &inst); err == arm64asm.ErrUnsupported {
+				probs = append(probs, Problem{
+					Addr:   pc + uint64(i),
+					Inst:   arm64asm.GNUSyntax(inst),
+					Reason: err.Error(),
+				})
+			}
+		}
+
+	case "ppc64", "ppc64le":
+		if t.PPC64 == 0 {
+			return nil, nil
+		}
+		var ord binary.ByteOrder = binary.BigEndian
+		if arch == "ppc64le" {
+			ord = binary.LittleEndian
+		}
+		d := ppc64asm.Decoder{CPU: t.PPC64}
+		for i := 0; i+4 <= len(code); {
+			inst, err := d.Decode(code[i:], ord)
+			if err == ppc64asm.ErrUnsupported {
+				probs = append(probs, Problem{
+					Addr:   pc + uint64(i),
+					Inst:   ppc64asm.GNUSyntax(inst, pc+uint64(i)),
+					Reason: fmt.Sprintf("%v on %v", err, t.PPC64),
+				})
+			}
+			if inst.Len == 0 {
+				i += 4
+				continue
+			}
+			i += inst.Len
+		}
+
+	default:
+		return nil, fmt.Errorf("migrate: unsupported architecture %q", arch)
+	}
+	return probs, nil
+}
+
+// A Func is a function with problems.
+type Func struct {
+	Name     string
+	Addr     uint64
+	Problems []Problem
+}
+
+// CheckFile returns the functions of the ELF or Mach-O executable
+// file that have problems running on the target t, in address order.
+// It examines only code covered by function symbols, so it finds
+// nothing in a stripped executable.
+func CheckFile(file string, t *Target) ([]Func, error) {
+	f, err := objfile.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var funcs []Func
+	for _, s := range f.Syms {
+		if s.Size == 0 {
+			continue
+		}
+		code, err := f.Text(s.Addr, s.Addr+s.Size)
+		if err != nil {
+			continue // symbol outside the executable sections
+		}
+		probs, err := Check(f.Arch, code, s.Addr, t)
+		if err != nil {
+			return nil, err
+		}
+		if len(probs) > 0 {
+			funcs = append(funcs, Func{s.Name, s.Addr, probs})
+		}
+	}
+	return funcs, nil
+}
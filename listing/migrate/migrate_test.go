@@ -0,0 +1,155 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package migrate
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/ppc64/ppc64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+func words(ord binary.ByteOrder, ws ...uint32) []byte {
+	b := make([]byte, 4*len(ws))
+	for i, w := range ws {
+		ord.PutUint32(b[4*i:], w)
+	}
+	return b
+}
+
+func TestCheck(t *testing.T) {
+	var sse2 x86asm.FeatureSet
+	sse2.Add(x86asm.FeatureSSE)
+	sse2.Add(x86asm.FeatureSSE2)
+	var none x86asm.FeatureSet
+	noFP := arm64asm.IDRegs{PFR0: 0xF<<16 | 0xF<<20}
+	le, be := binary.LittleEndian, binary.BigEndian
+
+	tests := []struct {
+		arch string
+		code []byte
+		t    Target
+		want string
+	}{
+		{
+			"amd64",
+			[]byte{0x48, 0x01, 0xd8, 0xc5, 0xfe, 0x6f, 0x06, 0x90}, // add %rbx,%rax; vmovdqu (%rsi),%ymm0; nop
+			Target{X86: &sse2},
+			"[0x1003: vmovdqu (%rsi),%ymm0: instruction not supported by processor]",
+		},
+		{
+			"amd64",
+			[]byte{0xc5, 0xfe, 0x6f, 0x06},
+			Target{},
+			"[]",
+		},
+		{
+			"amd64",
+			[]byte{0xf3, 0x48, 0x0f, 0xbd, 0xc1, 0xf3, 0x0f, 0xb8, 0xc1, 0x0f, 0x38, 0xf0, 0x06}, // lzcnt %rcx,%rax; popcnt %ecx,%eax; movbe (%rsi),%eax
+			Target{X86: &none},
+			"[0x1000: lzcnt %rcx,%rax: executed as rep bsr %rcx,%rax by processor " +
+				"0x1005: popcnt %ecx,%eax: instruction not supported by processor " +
+				"0x1009: movbe (%rsi),%eax: instruction not supported by processor]",
+		},
+		{
+			"amd64",
+			[]byte{0xf3, 0x0f, 0x1e, 0xfa, 0xf3, 0x48, 0x0f, 0x1e, 0xc8, 0xc3}, // endbr64; rdsspq %rax; ret
+			Target{X86: &none},
+			"[]",
+		},
+		{
+			"arm64",
+			words(le, 0x8b020020, 0x1e622820), // add x0, x1, x2; fadd d0, d1, d2
+			Target{ARM64: &noFP},
+			"[0x1004: fadd d0, d1, d2: instruction not implemented by processor]",
+		},
+		{
+			"ppc64",
+			words(be, 0x7c642a14, 0x7c6005e6), // add r3,r4,r5; darn r3,0
+			Target{PPC64: ppc64asm.POWER8},
+			"[0x1004: darn r3,0: instruction not supported by processor on POWER8]",
+		},
+		{
+			"ppc64le",
+			words(le, 0x06000000, 0x38600000, 0x7c642a14), // pli r3,0; add r3,r4,r5
+			Target{PPC64: ppc64asm.POWER9},
+			"[0x1000: pli r3,0: instruction not supported by processor on POWER9]",
+		},
+		{
+			"arm",
+			words(le, 0xe0810002, 0xe1020091, 0xee070fba), // add r0, r1, r2; swp r0, r1, [r2]; mcr p15, 0, r0, c7, c10, 5
+			Target{ARMv8: true},
+			"[0x1004: swp r0, r1, [r2]: removed in ARMv8 AArch32; use LDREX/STREX " +
+				"0x1008: .word 0xee070fba: deprecated in ARMv8 AArch32, disabled by SCTLR.CP15BEN; use ISB/DSB/DMB (deprecated)]",
+		},
+	}
+	for _, tt := range tests {
+		probs, err := Check(tt.arch, tt.code, 0x1000, &tt.t)
+		if err != nil {
+			t.Errorf("Check(%s, % x): %v", tt.arch, tt.code, err)
+			continue
+		}
+		if got := fmt.Sprint(probs); got != tt.want {
+			t.Errorf("Check(%s, % x):\nhave %s\nwant %s", tt.arch, tt.code, got, tt.want)
+		}
+	}
+	if _, err := Check("mips", nil, 0, &Target{}); err == nil {
+		t.Errorf("Check(mips): no error")
+	}
+}
+
+func TestCheckFile(t *testing.T) {
+	if runtime.GOARCH != "amd64" || runtime.GOOS != "linux" {
+		t.Skip("test builds an amd64 ELF binary")
+	}
+	if testing.Short() {
+		t.Skip("skipping go build in short mode")
+	}
+	gotool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	// Test binaries are built without symbols, so build a program.
+	dir := t.TempDir()
+	src := filepath.Join(dir, "prog.go")
+	exe := filepath.Join(dir, "prog")
+	if err := os.WriteFile(src, []byte("package main\n\nfunc main() {}\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(gotool, "build", "-o", exe, src)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+
+	// The runtime's memmove uses AVX when it is available.
+	var sse2 x86asm.FeatureSet
+	sse2.Add(x86asm.FeatureSSE)
+	sse2.Add(x86asm.FeatureSSE2)
+	funcs, err := CheckFile(exe, &Target{X86: &sse2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for i, f := range funcs {
+		if i > 0 && f.Addr <= funcs[i-1].Addr {
+			t.Errorf("%s at %#x follows %s at %#x", f.Name, f.Addr, funcs[i-1].Name, funcs[i-1].Addr)
+		}
+		if f.Name == "runtime.memmove" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("CheckFile did not report runtime.memmove; reported %d functions", len(funcs))
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pprof disassembles executables the way the pprof tool's
+// binutils package expects, so that pprof can show disassembly
+// without running an external objdump.
+//
+// Disasm has the signature of the Disasm method of pprof's ObjTool
+// interface, and Inst has the fields of pprof's plugin.Inst, so an
+// ObjTool can forward to Disasm and copy the results field by field.
+package pprof
+
+import (
+	"sort"
+
+	"golang.org/x/arch/listing"
+	"golang.org/x/arch/listing/internal/objfile"
+)
+
+// An Inst is a disassembled instruction, like pprof's plugin.Inst.
+type Inst struct {
+	Addr     uint64 // instruction address
+	Text     string // instruction text
+	Function string // name of the function containing the instruction
+	File     string // source file, if known
+	Line     int    // source line, if known
+}
+
+// Disasm disassembles the instructions of the executable file
+// whose addresses are in [start, end). If intelSyntax is set,
+// x86 instructions are shown in Intel syntax rather than AT&T
+// (GNU) syntax; other architectures always use GNU syntax.
+// File must be an ELF or Mach-O executable for 386, amd64, arm,
+// arm64, ppc64 or ppc64le. File and line information comes from
+// DWARF, when the file has it.
+func Disasm(file string, start, end uint64, intelSyntax bool) ([]Inst, error) {
+	obj, err := objfile.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	code, err := obj.Text(start, end)
+	if err != nil {
+		return nil, err
+	}
+	syntax := "gnu"
+	if intelSyntax && (obj.Arch == "386" || obj.Arch == "amd64") {
+		syntax = "intel"
+	}
+	cfg := listing.Config{
+		Arch:    obj.Arch,
+		Syntax:  syntax,
+		Symname: obj.Symname,
+	}
+	lines, err := cfg.Lines(code, start)
+	if err != nil {
+		return nil, err
+	}
+	pcs := obj.LineTable(start, end)
+
+	insts := make([]Inst, len(lines))
+	for i := range lines {
+		l := &lines[i]
+		inst := Inst{Addr: l.Addr, Text: l.Asm()}
+		inst.Function, _ = obj.Symname(l.Addr)
+		if j := sort.Search(len(pcs), func(j int) bool { return pcs[j].Addr > l.Addr }); j > 0 {
+			inst.File, inst.Line = pcs[j-1].File, pcs[j-1].Line
+		}
+		insts[i] = inst
+	}
+	return insts, nil
+}
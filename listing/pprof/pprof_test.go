@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pprof
+
+import (
+	"debug/elf"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+const testProg = `package main
+
+func main() {
+	println(f(1))
+}
+
+//go:noinline
+func f(x int) int {
+	return x*3 + 1
+}
+`
+
+func TestDisasm(t *testing.T) {
+	switch runtime.GOARCH {
+	case "386", "amd64", "arm", "arm64", "ppc64", "ppc64le":
+	default:
+		t.Skipf("no disassembler for %s", runtime.GOARCH)
+	}
+	if runtime.GOOS != "linux" {
+		t.Skip("test builds an ELF binary")
+	}
+	if testing.Short() {
+		t.Skip("skipping go build in short mode")
+	}
+	gotool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	// Test binaries are built without symbols, so build a program.
+	dir := t.TempDir()
+	src := filepath.Join(dir, "prog.go")
+	exe := filepath.Join(dir, "prog")
+	if err := os.WriteFile(src, []byte(testProg), 0666); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(gotool, "build", "-o", exe, src)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+
+	f, err := elf.Open(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	syms, err := f.Symbols()
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const name = "main.f"
+	var sym elf.Symbol
+	for _, s := range syms {
+		if s.Name == name {
+			sym = s
+		}
+	}
+	if sym.Size == 0 {
+		t.Fatalf("no symbol %s", name)
+	}
+
+	insts, err := Disasm(exe, sym.Value, sym.Value+sym.Size, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) == 0 || insts[0].Addr != sym.Value {
+		t.Fatalf("Disasm returned %d instructions, first %+v; want first at %#x", len(insts), insts, sym.Value)
+	}
+	sawLine := false
+	for _, inst := range insts {
+		if inst.Function != name {
+			t.Errorf("%#x: Function = %q, want %q", inst.Addr, inst.Function, name)
+		}
+		if inst.Text == "" {
+			t.Errorf("%#x: no text", inst.Addr)
+		}
+		if strings.HasSuffix(inst.File, "prog.go") && inst.Line == 9 {
+			sawLine = true
+		}
+	}
+	if !sawLine {
+		t.Errorf("no instruction attributed to prog.go:9")
+	}
+
+	if _, err := Disasm(exe, sym.Value+sym.Size, sym.Value, false); err == nil {
+		t.Errorf("Disasm with end < start succeeded")
+	}
+}
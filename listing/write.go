@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listing
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteText writes lines to w as an objdump-style text listing:
+// a "name:" line before each instruction that starts a symbol,
+// then one line per instruction giving its address, encoding,
+// assembly and relocations, in aligned columns.
+func WriteText(w io.Writer, lines []Line) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for i := range lines {
+		l := &lines[i]
+		if l.Label != "" {
+			fmt.Fprintf(tw, "%s:\n", l.Label)
+		}
+		fmt.Fprintf(tw, "  %#x\t% x\t%s", l.Addr, l.Bytes, l.Asm())
+		if l.Reloc != "" {
+			fmt.Fprintf(tw, "\t// %s", l.Reloc)
+		}
+		fmt.Fprintf(tw, "\n")
+	}
+	return tw.Flush()
+}
+
+// WriteHTML writes lines to w as an HTML listing.
+// The listing is a single <pre class="listing"> element. Within it,
+// each token of an instruction other than plain text is wrapped in a
+// <span> whose class is the lower-case name of its kind, such as
+// "mnemonic" or "register", so that style sheets can color them.
+// Labels, encodings and relocations use the classes "label", "bytes"
+// and "reloc".
+func WriteHTML(w io.Writer, lines []Line) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("<pre class=\"listing\">\n")
+	for i := range lines {
+		l := &lines[i]
+		if l.Label != "" {
+			fmt.Fprintf(bw, "<span class=\"label\">%s:</span>\n", html.EscapeString(l.Label))
+		}
+		fmt.Fprintf(bw, "  %#x  <span class=\"bytes\">% x</span>  ", l.Addr, l.Bytes)
+		if l.Tokens == nil {
+			bw.WriteString("?")
+		}
+		for _, t := range l.Tokens {
+			if t.Kind == Text {
+				bw.WriteString(html.EscapeString(t.Text))
+				continue
+			}
+			fmt.Fprintf(bw, "<span class=\"%s\">%s</span>", strings.ToLower(t.Kind.String()), html.EscapeString(t.Text))
+		}
+		if l.Reloc != "" {
+			fmt.Fprintf(bw, "  <span class=\"reloc\">// %s</span>", html.EscapeString(l.Reloc))
+		}
+		bw.WriteString("\n")
+	}
+	bw.WriteString("</pre>\n")
+	return bw.Flush()
+}
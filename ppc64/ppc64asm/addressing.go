@@ -0,0 +1,78 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// An Addressing summarizes how a function addresses its data and the
+// functions it calls: relative to the TOC pointer in r2, as in the
+// small and medium code models of the ELFv2 ABI, or relative to the
+// program counter, with the prefixed instructions of POWER10.
+type Addressing struct {
+	TOC   int // D-form or DS-form instructions with base register r2
+	PCRel int // prefixed instructions with the R bit set
+}
+
+// Mixed reports whether the function uses both kinds of addressing.
+// A function that does must keep a valid TOC pointer even though
+// some of its code does not need one, which usually means it was
+// built from objects compiled for different ABIs.
+func (a Addressing) Mixed() bool {
+	return a.TOC > 0 && a.PCRel > 0
+}
+
+func (a Addressing) String() string {
+	switch {
+	case a.Mixed():
+		return fmt.Sprintf("mixed (%d TOC, %d pcrel)", a.TOC, a.PCRel)
+	case a.TOC > 0:
+		return "TOC"
+	case a.PCRel > 0:
+		return "pcrel"
+	}
+	return "none"
+}
+
+// AddressingOf counts the instructions in code, the instructions of a
+// function in byte order ord, that address memory relative to the TOC
+// pointer or to the program counter. The TOC pointer setup of a global
+// entry point (addis r2,r12,...; addi r2,r2,...) counts as TOC use,
+// but saving and restoring r2 around calls does not.
+func AddressingOf(code []byte, ord binary.ByteOrder) Addressing {
+	var a Addressing
+	for i := 0; i+4 <= len(code); i += 4 {
+		w := ord.Uint32(code[i:])
+		if w>>26 == 1 && i+8 <= len(code) {
+			// Prefixed instruction. Only the 8LS and MLS forms,
+			// types 0 and 2, have the R bit and a base register.
+			if typ := w >> 24 & 3; typ == 0 || typ == 2 {
+				switch {
+				case w&(1<<20) != 0:
+					a.PCRel++
+				case ord.Uint32(code[i+4:])>>16&31 == 2:
+					a.TOC++
+				}
+			}
+			i += 4
+			continue
+		}
+		if isDForm(w>>26) && w>>16&31 == 2 {
+			a.TOC++
+		}
+	}
+	return a
+}
+
+// isDForm reports whether instructions with primary opcode op are
+// D-form or DS-form instructions whose RA field is a base register:
+// addi, addis and the loads and stores with a displacement.
+func isDForm(op uint32) bool {
+	return op == 14 || op == 15 || // addi, addis
+		32 <= op && op <= 58 || // lwz through ld
+		op == 61 || op == 62 // stxsd, std
+}
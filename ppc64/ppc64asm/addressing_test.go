@@ -0,0 +1,39 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestAddressingOf(t *testing.T) {
+	tests := []struct {
+		words []uint32
+		want  Addressing
+		str   string
+	}{
+		{[]uint32{0x7c642a14, 0xf8410018, 0xe8410018}, Addressing{}, "none"}, // add; std r2,24(r1); ld r2,24(r1)
+		{[]uint32{0x3c620000, 0xe8630008, 0xe8820010}, Addressing{TOC: 2}, "TOC"},
+		{[]uint32{0x04000000, 0xe4620000}, Addressing{TOC: 1}, "TOC"}, // pld r3,0(r2)
+		{[]uint32{0x06100000, 0x38600000, 0x7c642a14}, Addressing{PCRel: 1}, "pcrel"},
+		{[]uint32{0x3c620000, 0x06100000, 0x38600000}, Addressing{TOC: 1, PCRel: 1}, "mixed (1 TOC, 1 pcrel)"},
+	}
+	for _, tt := range tests {
+		for _, ord := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			code := make([]byte, 4*len(tt.words))
+			for i, w := range tt.words {
+				ord.PutUint32(code[4*i:], w)
+			}
+			a := AddressingOf(code, ord)
+			if a != tt.want || a.String() != tt.str {
+				t.Errorf("AddressingOf(%x, %v) = %+v %q, want %+v %q", tt.words, ord, a, a, tt.want, tt.str)
+			}
+			if a.Mixed() != (tt.want.TOC > 0 && tt.want.PCRel > 0) {
+				t.Errorf("AddressingOf(%x, %v).Mixed() = %v", tt.words, ord, a.Mixed())
+			}
+		}
+	}
+}
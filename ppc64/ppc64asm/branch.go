@@ -0,0 +1,142 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import "fmt"
+
+// A BranchRange is the range of targets that a direct branch can encode:
+// offsets from the branch, or for an absolute branch (ba, bla, bca,
+// bcla), addresses, which are sign-extended from the encoded field.
+type BranchRange struct {
+	Min, Max int64 // inclusive bounds, multiples of 4
+	Absolute bool
+}
+
+// BranchRangeOf returns the range of targets of inst: ±32 MiB for the
+// I-form branches (b, ba, bl, bla) and ±32 KiB for the B-form branches
+// (bc, bca, bcl, bcla). The boolean result reports whether inst is one
+// of these. Branches to LR, CTR and TAR have no encoded target.
+func BranchRangeOf(inst Inst) (BranchRange, bool) {
+	if inst.Len != 4 {
+		return BranchRange{}, false
+	}
+	abs := inst.Enc&2 != 0 // AA
+	switch inst.Enc >> 26 {
+	case 18: // I-form
+		return BranchRange{-1 << 25, 1<<25 - 4, abs}, true
+	case 16: // B-form
+		return BranchRange{-1 << 15, 1<<15 - 4, abs}, true
+	}
+	return BranchRange{}, false
+}
+
+// Margin returns the distance from the value that encodes target,
+// for a branch at pc, to the nearer bound of r, or a negative
+// distance if that value is outside r.
+func (r BranchRange) Margin(pc, target uint64) int64 {
+	v := int64(target)
+	if !r.Absolute {
+		v = int64(target - pc)
+	}
+	if v-r.Min < r.Max-v {
+		return v - r.Min
+	}
+	return r.Max - v
+}
+
+// A Stub is an instruction sequence, placed within range of a branch,
+// that a linker or binary rewriter can retarget the branch to when the
+// branch cannot reach its target directly. Because no stub sets LR,
+// a stub works for calls (bl, bcl) as well as for plain branches.
+type Stub uint8
+
+const (
+	// NoStub means that the branch reaches its target.
+	NoStub Stub = iota
+
+	// StubBranch is a single b, extending the reach of a
+	// conditional branch to ±32 MiB of the stub.
+	StubBranch
+
+	// StubPCRel loads the target address with the POWER10
+	// prefixed pla instruction, reaching ±8 GiB of the stub.
+	StubPCRel
+
+	// StubAbsolute builds the 64-bit target address in r12,
+	// reaching any address.
+	StubAbsolute
+)
+
+var stubNames = [...]string{
+	NoStub:       "NoStub",
+	StubBranch:   "StubBranch",
+	StubPCRel:    "StubPCRel",
+	StubAbsolute: "StubAbsolute",
+}
+
+func (s Stub) String() string {
+	if int(s) < len(stubNames) {
+		return stubNames[s]
+	}
+	return fmt.Sprintf("Stub(%d)", int(s))
+}
+
+// Pattern returns the instructions of the stub in GNU syntax,
+// using "target" for the target address and GNU assembler
+// operators for its parts. The stubs other than StubBranch
+// overwrite r12, the register the ELFv2 ABI sets aside
+// for this purpose, and CTR.
+func (s Stub) Pattern() []string {
+	switch s {
+	case StubBranch:
+		return []string{"b target"}
+	case StubPCRel:
+		return []string{"pla r12,target@pcrel", "mtctr r12", "bctr"}
+	case StubAbsolute:
+		return []string{
+			"lis r12,target@highest",
+			"ori r12,r12,target@higher",
+			"sldi r12,r12,32",
+			"oris r12,r12,target@h",
+			"ori r12,r12,target@l",
+			"mtctr r12",
+			"bctr",
+		}
+	}
+	return nil
+}
+
+// CheckBranch reports whether the direct branch inst, located at pc,
+// can reach target. It returns the margin, as computed by
+// BranchRange.Margin, and if the branch cannot reach target, the
+// smallest stub that can, assuming the stub is placed near the branch:
+// StubBranch for a conditional branch whose target is within ±32 MiB,
+// and otherwise StubPCRel for code that may use POWER10 instructions
+// (if pcrel is set) and StubAbsolute for other code.
+// CheckBranch returns an error if inst is not a direct branch or if
+// target is not a multiple of 4.
+func CheckBranch(inst Inst, pc, target uint64, pcrel bool) (margin int64, stub Stub, err error) {
+	r, ok := BranchRangeOf(inst)
+	if !ok {
+		return 0, NoStub, fmt.Errorf("ppc64asm: %v is not a direct branch", inst.Op)
+	}
+	if target%4 != 0 {
+		return 0, NoStub, fmt.Errorf("ppc64asm: branch target %#x is not a multiple of 4", target)
+	}
+	margin = r.Margin(pc, target)
+	if margin >= 0 {
+		return margin, NoStub, nil
+	}
+	off := int64(target - pc)
+	switch {
+	case inst.Enc>>26 == 16 && -1<<25 <= off && off < 1<<25:
+		stub = StubBranch
+	case pcrel && -1<<33 <= off && off < 1<<33:
+		stub = StubPCRel
+	default:
+		stub = StubAbsolute
+	}
+	return margin, stub, nil
+}
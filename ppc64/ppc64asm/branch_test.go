@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestCheckBranch(t *testing.T) {
+	const pc = 0x200000000
+	tests := []struct {
+		enc    uint32
+		target uint64
+		pcrel  bool
+		margin int64
+		stub   Stub
+	}{
+		{0x48000000, pc + 1<<25 - 4, false, 0, NoStub},                     // b
+		{0x48000001, pc - 1<<25, false, 0, NoStub},                         // bl
+		{0x48000000, pc + 0x100, false, 1<<25 - 0x104, NoStub},             // b
+		{0x48000000, pc + 1<<25, false, -4, StubAbsolute},                  // b
+		{0x48000001, pc + 1<<25, true, -4, StubPCRel},                      // bl
+		{0x48000001, pc + 1<<33, true, -(1<<33 - 1<<25 + 4), StubAbsolute}, // bl
+		{0x41820000, pc + 0x7ffc, false, 0, NoStub},                        // beq
+		{0x41820000, pc + 0x8000, false, -4, StubBranch},                   // beq
+		{0x41820001, pc - 0x8004, true, -4, StubBranch},                    // beql
+		{0x41820000, pc + 1<<25, true, -(1<<25 - 0x7ffc), StubPCRel},       // beq
+		{0x48000002, 0x1000, false, 1<<25 - 0x1004, NoStub},                // ba
+		{0x48000002, pc, false, -(pc - 1<<25 + 4), StubAbsolute},           // ba
+	}
+	var src [4]byte
+	for _, tt := range tests {
+		binary.BigEndian.PutUint32(src[:], tt.enc)
+		inst, err := Decode(src[:], binary.BigEndian)
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		margin, stub, err := CheckBranch(inst, pc, tt.target, tt.pcrel)
+		if margin != tt.margin || stub != tt.stub || err != nil {
+			t.Errorf("CheckBranch(%s, %#x, %#x, %v) = %#x, %v, %v, want %#x, %v", GNUSyntax(inst, pc), uint64(pc), tt.target, tt.pcrel, margin, stub, err, tt.margin, tt.stub)
+		}
+	}
+
+	binary.BigEndian.PutUint32(src[:], 0x4e800020) // blr
+	blr, _ := Decode(src[:], binary.BigEndian)
+	if _, _, err := CheckBranch(blr, pc, pc, false); err == nil {
+		t.Errorf("CheckBranch(blr): no error")
+	}
+	binary.BigEndian.PutUint32(src[:], 0x48000000) // b
+	b, _ := Decode(src[:], binary.BigEndian)
+	if _, _, err := CheckBranch(b, pc, pc+2, false); err == nil {
+		t.Errorf("CheckBranch(b, misaligned target): no error")
+	}
+	if got := len(StubAbsolute.Pattern()); got != 7 {
+		t.Errorf("len(StubAbsolute.Pattern()) = %d, want 7", got)
+	}
+}
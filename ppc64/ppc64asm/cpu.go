@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupported is returned by a Decoder configured with a CPU
+// for an instruction introduced after that processor.
+// The Decoder still returns the decoded instruction along with the error.
+var ErrUnsupported = errors.New("instruction not supported by processor")
+
+// An isaLevel is a version of the Power ISA, as recorded for each
+// instruction form in the decoding tables. Later versions are greater.
+// The constants follow the isaversion constants of ppc64map.
+type isaLevel uint8
+
+const (
+	isaP1  isaLevel = iota // POWER
+	isaP2                  // POWER2
+	isaPPC                 // PowerPC
+	isaV200
+	isaV201
+	isaV202
+	isaV203
+	isaV205
+	isaV206
+	isaV207
+	isaV30
+	isaV30B
+	isaV30C
+	isaV31
+	isaV31B
+)
+
+// A CPU is a generation of IBM POWER processor.
+type CPU uint8
+
+const (
+	_       CPU = iota
+	POWER8      // Power ISA 2.07
+	POWER9      // Power ISA 3.0
+	POWER10     // Power ISA 3.1
+	POWER11     // Power ISA 3.1, as POWER10
+)
+
+var cpuNames = [...]string{
+	POWER8:  "POWER8",
+	POWER9:  "POWER9",
+	POWER10: "POWER10",
+	POWER11: "POWER11",
+}
+
+func (c CPU) String() string {
+	if 0 < c && int(c) < len(cpuNames) {
+		return cpuNames[c]
+	}
+	return fmt.Sprintf("CPU(%d)", int(c))
+}
+
+// maxISA returns the newest ISA version that c implements.
+func (c CPU) maxISA() isaLevel {
+	switch c {
+	case POWER8:
+		return isaV207
+	case POWER9:
+		return isaV30C
+	}
+	return isaV31B
+}
+
+// A Decoder decodes instructions for a particular processor.
+// The zero Decoder accepts every instruction the package knows,
+// like the package-level Decode function.
+type Decoder struct {
+	// CPU, if non-zero, is the processor that will run the code.
+	// Decode then returns ErrUnsupported for instructions that
+	// a later version of the Power ISA introduced.
+	CPU CPU
+}
+
+// Decode decodes the leading bytes in src as a single instruction
+// using byte order ord. It is otherwise like the package-level
+// Decode function.
+func (d *Decoder) Decode(src []byte, ord binary.ByteOrder) (Inst, error) {
+	inst, isa, err := decode1(src, ord)
+	if err == nil && d.CPU != 0 && inst.Op != 0 && isa > d.CPU.maxISA() {
+		err = ErrUnsupported
+	}
+	return inst, err
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestDecoderCPU(t *testing.T) {
+	tests := []struct {
+		enc    []uint32
+		oldest CPU // oldest processor that implements it
+	}{
+		{[]uint32{0x7c642a14}, POWER8},              // add r3,r4,r5
+		{[]uint32{0x7c6005e6}, POWER9},              // darn r3,0
+		{[]uint32{0x06000000, 0x38600000}, POWER10}, // pli r3,0
+		{[]uint32{0x7c0405e4}, POWER10},             // hashchk r0,-512(r4)
+	}
+	for _, tt := range tests {
+		src := make([]byte, 4*len(tt.enc))
+		for i, w := range tt.enc {
+			binary.BigEndian.PutUint32(src[4*i:], w)
+		}
+		want, err := Decode(src, binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		for cpu := POWER8; cpu <= POWER11; cpu++ {
+			d := Decoder{CPU: cpu}
+			inst, err := d.Decode(src, binary.BigEndian)
+			if inst != want {
+				t.Errorf("%v: Decode(%#x) = %v, want %v", cpu, tt.enc, inst, want)
+			}
+			wantErr := error(nil)
+			if cpu < tt.oldest {
+				wantErr = ErrUnsupported
+			}
+			if err != wantErr {
+				t.Errorf("%v: Decode(%#x) (%v): err = %v, want %v", cpu, tt.enc, inst, err, wantErr)
+			}
+		}
+	}
+}
@@ -33,6 +33,7 @@ type instFormat struct {
 	Mask     uint64
 	Value    uint64
 	DontCare uint64
+	ISA      isaLevel // ISA version that introduced the form
 	Args     [6]*argField
 }
 
@@ -169,8 +170,15 @@ var decoderCover []bool
 // Decode decodes the leading bytes in src as a single instruction using
 // byte order ord.
 func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
+	inst, _, err = decode1(src, ord)
+	return inst, err
+}
+
+// decode1 is the implementation of Decode. It also returns the
+// ISA version that introduced the decoded instruction form.
+func decode1(src []byte, ord binary.ByteOrder) (inst Inst, isa isaLevel, err error) {
 	if len(src) < 4 {
-		return inst, errShort
+		return inst, 0, errShort
 	}
 	if decoderCover == nil {
 		decoderCover = make([]bool, len(instFormats))
@@ -184,7 +192,7 @@ func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
 		// This is a prefixed instruction
 		inst.Len = 8
 		if len(src) < 8 {
-			return inst, errShort
+			return inst, 0, errShort
 		}
 		// Merge the suffixed word.
 		ui_extn[1] = ord.Uint32(src[4:inst.Len])
@@ -208,6 +216,7 @@ func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
 			inst.Args[i] = argfield.Parse(ui_extn)
 		}
 		inst.Op = iform.Op
+		isa = iform.ISA
 		if debugDecode {
 			log.Printf("%#x: search entry %d", ui, i)
 			continue
@@ -215,7 +224,7 @@ func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
 		break
 	}
 	if inst.Op == 0 && inst.Enc != 0 {
-		return inst, errUnknown
+		return inst, 0, errUnknown
 	}
-	return inst, nil
+	return inst, isa, nil
 }
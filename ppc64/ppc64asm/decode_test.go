@@ -73,9 +73,12 @@ func decode(data []byte, t *testing.T, filename string) {
 		if err != nil {
 			out = "error: " + err.Error()
 		} else {
+			var toks []Token
+			emit := func(t Token) { toks = append(toks, t) }
 			switch syntax {
 			case "gnu":
 				out = GNUSyntax(inst, pc)
+				GNUSyntaxTokens(inst, pc, emit)
 			case "plan9":
 				pc := pc
 				// Hack: Setting PC to 0 effectively transforms the PC relative address
@@ -85,10 +88,14 @@ func decode(data []byte, t *testing.T, filename string) {
 					pc = 0
 				}
 				out = GoSyntax(inst, pc, symlookup)
+				GoSyntaxTokens(inst, pc, symlookup, emit)
 			default:
 				t.Errorf("unknown syntax %q", syntax)
 				continue
 			}
+			if s := joinTokens(toks); s != out {
+				t.Errorf("%s: Decode(%s) [%s] tokens = %q want %q", filename, f[0], syntax, s, out)
+			}
 		}
 		pc += uint64(size)
 		if out != asm || inst.Len != size {
@@ -0,0 +1,107 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import "fmt"
+
+// An ArgEncoding describes how an instruction argument is encoded.
+// The argument's value is formed by concatenating BitFields, most
+// significant first, and shifting the result left by Shift bits.
+type ArgEncoding struct {
+	Type      ArgType
+	Shift     uint8
+	BitFields BitFields
+}
+
+func (e ArgEncoding) String() string {
+	if e.Shift != 0 {
+		return fmt.Sprintf("%v%v<<%d", e.Type, e.BitFields, e.Shift)
+	}
+	return fmt.Sprintf("%v%v", e.Type, e.BitFields)
+}
+
+// ArgEncodings returns the encodings of the arguments of inst,
+// an instruction returned by Decode, in the same order as inst.Args.
+// It returns nil if inst does not match any instruction form.
+func ArgEncodings(inst Inst) []ArgEncoding {
+	ui := uint64(inst.Enc) << 32
+	if inst.Len == 8 {
+		ui |= uint64(inst.SuffixEnc)
+	}
+	for _, iform := range instFormats {
+		if ui&iform.Mask != iform.Value || iform.Op != inst.Op {
+			continue
+		}
+		var encs []ArgEncoding
+		for _, a := range iform.Args {
+			if a == nil {
+				break
+			}
+			encs = append(encs, ArgEncoding{a.Type, a.Shift, a.BitFields})
+		}
+		return encs
+	}
+	return nil
+}
+
+// Insert returns a copy of the instruction words i with the argument's
+// bit fields changed to encode v. For registers, v is the register
+// number; otherwise it is the numeric value of the argument, as for
+// Imm, Offset, PCRel and Label. Insert returns an error if v cannot
+// be encoded, because it is out of range or is not a multiple of
+// 1<<Shift.
+func (e ArgEncoding) Insert(i [2]uint32, v int64) ([2]uint32, error) {
+	n := e.BitFields.NumBits()
+	if v&(1<<e.Shift-1) != 0 {
+		return i, fmt.Errorf("ppc64asm: %v value %#x is not a multiple of %d", e.Type, v, 1<<e.Shift)
+	}
+	f := v >> e.Shift
+	switch e.Type {
+	case TypeImmSigned, TypeOffset, TypePCRel, TypeLabel:
+		if f < -(1<<(n-1)) || f >= 1<<(n-1) {
+			return i, fmt.Errorf("ppc64asm: %v value %#x out of range", e.Type, v)
+		}
+	case TypeNegOffset:
+		neg := int64(-1) << n
+		if f&neg != neg {
+			return i, fmt.Errorf("ppc64asm: %v value %#x out of range", e.Type, v)
+		}
+	case TypeVecSpReg:
+		if f&1 != 0 {
+			return i, fmt.Errorf("ppc64asm: %v register %d is not even", e.Type, v)
+		}
+		f >>= 1
+		fallthrough
+	default:
+		if f < 0 || f >= 1<<n {
+			return i, fmt.Errorf("ppc64asm: %v value %#x out of range", e.Type, v)
+		}
+	}
+	return e.BitFields.Insert(i, uint64(f)), nil
+}
+
+// Insert returns a copy of i with the bit-field b set to the low b.Bits bits of u.
+// Insert will panic if b is invalid.
+func (b BitField) Insert(i [2]uint32, u uint32) [2]uint32 {
+	if b.Bits > 32 || b.Bits == 0 || b.Offs > 31 || b.Offs+b.Bits > 32 {
+		panic(fmt.Sprintf("invalid bitfield %v", b))
+	}
+	shift := 32 - b.Offs - b.Bits
+	mask := uint32((uint64(1)<<b.Bits - 1) << shift)
+	i[b.Word] = i[b.Word]&^mask | u<<shift&mask
+	return i
+}
+
+// Insert returns a copy of i with the bitfields set to the low bits of u,
+// split in the same way that Parse concatenates them.
+// Insert will panic if any bitfield in bs is invalid.
+func (bs BitFields) Insert(i [2]uint32, u uint64) [2]uint32 {
+	for j := len(bs) - 1; j >= 0; j-- {
+		b := bs[j]
+		i = b.Insert(i, uint32(u))
+		u >>= b.Bits
+	}
+	return i
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestArgEncodings(t *testing.T) {
+	var tests = []struct {
+		enc  []byte
+		want string
+	}{
+		{[]byte{0xe8, 0x61, 0x00, 0x08}, "[Reg<[6:10]> Offset<[16:29]><<2 Reg<[11:15]>]"},                                                   // ld r3,8(r1)
+		{[]byte{0x48, 0x00, 0x00, 0x10}, "[PCRel<[6:29]><<2]"},                                                                              // b 0x10
+		{[]byte{0x06, 0x00, 0x00, 0x00, 0x38, 0x60, 0x00, 0x00}, "[Reg<[6:10]> Reg<[11:15]> ImmSigned<[14:31]|[16:31]> ImmUnsigned<[11]>]"}, // paddi r3,0,0,0
+	}
+	for _, tt := range tests {
+		inst, err := Decode(tt.enc, binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(% x): %v", tt.enc, err)
+			continue
+		}
+		var got []string
+		for _, e := range ArgEncodings(inst) {
+			got = append(got, e.String())
+		}
+		if s := "[" + strings.Join(got, " ") + "]"; s != tt.want {
+			t.Errorf("ArgEncodings(%v) = %s, want %s", inst, s, tt.want)
+		}
+	}
+}
+
+func TestArgEncodingInsert(t *testing.T) {
+	var tests = []struct {
+		enc  uint32
+		arg  int
+		v    int64
+		want string // "" if Insert should fail
+	}{
+		{0xe8610008, 1, 0x100, "ld r3,256(r1)"},
+		{0xe8610008, 1, -8, "ld r3,-8(r1)"},
+		{0xe8610008, 1, 6, ""},       // not a multiple of 4
+		{0xe8610008, 1, 1 << 15, ""}, // out of range
+		{0xe8610008, 0, 31, "ld r31,8(r1)"},
+		{0xe8610008, 0, 32, ""},
+		{0x48000010, 0, -4, "b 0xfffffffffffffffc"},
+	}
+	for _, tt := range tests {
+		var w [4]byte
+		binary.BigEndian.PutUint32(w[:], tt.enc)
+		inst, err := Decode(w[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		encs := ArgEncodings(inst)
+		i, err := encs[tt.arg].Insert([2]uint32{tt.enc, 0}, tt.v)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%v: Insert(%d, %#x) = %#x, want error", inst, tt.arg, tt.v, i[0])
+			}
+			if i != [2]uint32{tt.enc, 0} {
+				t.Errorf("%v: failed Insert(%d, %#x) changed the encoding", inst, tt.arg, tt.v)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: Insert(%d, %#x): %v", inst, tt.arg, tt.v, err)
+			continue
+		}
+		binary.BigEndian.PutUint32(w[:], i[0])
+		inst2, err := Decode(w[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", i[0], err)
+			continue
+		}
+		if s := GNUSyntax(inst2, 0); s != tt.want {
+			t.Errorf("%v: Insert(%d, %#x) = %s, want %s", inst, tt.arg, tt.v, s, tt.want)
+		}
+	}
+}
+
+func TestBitFieldsInsert(t *testing.T) {
+	bs := BitFields{{30, 2, 0}, {8, 4, 1}}
+	for _, u := range []uint64{0, 1, 0x15, 0x3f} {
+		i := bs.Insert([2]uint32{0xffffffff, 0}, u)
+		if got := bs.Parse(i); got != u {
+			t.Errorf("%v: Parse(Insert(%#x)) = %#x", bs, u, got)
+		}
+		if want := [2]uint32{0xfffffffc | uint32(u>>4), uint32(u&0xf) << 20}; !reflect.DeepEqual(i, want) {
+			t.Errorf("%v: Insert(%#x) = %#x, want %#x", bs, u, i, want)
+		}
+	}
+}
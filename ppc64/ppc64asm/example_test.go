@@ -0,0 +1,50 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"os"
+
+	"golang.org/x/arch/ppc64/ppc64asm"
+)
+
+func ExampleDecode() {
+	code := []byte{0x14, 0x2a, 0x64, 0x7c} // add r3,r4,r5, little-endian
+	inst, err := ppc64asm.Decode(code, binary.LittleEndian)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(inst.Op, inst.Len)
+	fmt.Println(ppc64asm.GNUSyntax(inst, 0x1000))
+	fmt.Println(ppc64asm.GoSyntax(inst, 0x1000, nil))
+	// Output:
+	// add 4
+	// add r3,r4,r5
+	// ADD R5,R4,R3
+}
+
+func ExampleDisassemble() {
+	// A function at address 0x1000 in a text section starting
+	// at 0x1000, as from an *elf.Section.
+	text := bytes.NewReader([]byte{
+		0x14, 0x2a, 0x64, 0x7c, // add r3,r4,r5
+		0x00, 0x00, 0x00, 0x06, // pli r3,0 (prefix)
+		0x00, 0x00, 0x60, 0x38, // pli r3,0 (suffix)
+		0x00, 0x00, 0x00, 0x00, // undefined
+		0x20, 0x00, 0x80, 0x4e, // blr
+	})
+	if err := ppc64asm.Disassemble(os.Stdout, text, 0x1000, 0x1000, 0x1014, binary.LittleEndian); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// 1000:	7c642a14	add r3,r4,r5
+	// 1004:	06000000 38600000	pli r3,0
+	// 100c:	00000000	.long 0x0
+	// 1010:	4e800020	blr
+}
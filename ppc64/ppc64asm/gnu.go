@@ -5,7 +5,6 @@
 package ppc64asm
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
 )
@@ -20,13 +19,17 @@ var (
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This form typically matches the syntax defined in the Power ISA Reference Manual.
 func GNUSyntax(inst Inst, pc uint64) string {
-	var buf bytes.Buffer
+	return gnuTokens(inst, pc).String()
+}
+
+func gnuTokens(inst Inst, pc uint64) tokens {
+	var buf tokens
 	// When there are all 0s, identify them as the disassembler
 	// in binutils would.
 	if inst.Enc == 0 {
-		return ".long 0x0"
+		return tokens{{TokenMnemonic, ".long"}, {TokenText, " "}, {TokenImmediate, "0x0"}}
 	} else if inst.Op == 0 {
-		return "error: unknown instruction"
+		return tok(TokenText, "error: unknown instruction")
 	}
 
 	PC := pc
@@ -144,121 +147,113 @@ func GNUSyntax(inst Inst, pc uint64) string {
 		}
 
 		if at != 1 && startArg > 0 && bh <= 0 {
-			str := fmt.Sprintf("b%s", sfx)
+			buf.add(TokenMnemonic, "b"+sfx)
 			if startArg > 1 && (cr != 0 || bh > 0) {
-				str += fmt.Sprintf(" cr%d", cr)
+				buf.text(" ")
+				buf.add(TokenRegister, fmt.Sprintf("cr%d", cr))
 				sep = ","
 			}
-			buf.WriteString(str)
 			if startArg < 2 && bh == 0 {
-				str := fmt.Sprintf(" %s",
-					gnuArg(&inst, 1, inst.Args[1], PC))
-				buf.WriteString(str)
+				buf.text(" ")
+				buf.addAll(gnuArg(&inst, 1, inst.Args[1], PC))
 				startArg = 3
 			} else if bh == 0 {
 				startArg = 3
 			}
 		} else {
 			if startArg == 0 || bh > 0 || at == 1 {
-				buf.WriteString(inst.Op.String())
-				buf.WriteString(atsfx[at])
+				buf.add(TokenMnemonic, inst.Op.String()+atsfx[at])
 				startArg = 0
 			} else {
-				buf.WriteString("b" + sfx)
+				buf.add(TokenMnemonic, "b"+sfx)
 			}
 			if bh == 0 {
-				str := fmt.Sprintf(" %d,%s", bo, gnuArg(&inst, 1, inst.Args[1], PC))
-				buf.WriteString(str)
+				buf.text(" ")
+				buf.add(TokenImmediate, fmt.Sprintf("%d", bo))
+				buf.text(",")
+				buf.addAll(gnuArg(&inst, 1, inst.Args[1], PC))
 				startArg = 3
 			}
 		}
 
 	case "mtspr":
 		opcode := inst.Op.String()
-		buf.WriteString(opcode[0:2])
+		mn := opcode[0:2]
 		switch spr := inst.Args[0].(type) {
 		case SpReg:
 			switch spr {
 			case 1:
-				buf.WriteString("xer")
+				mn += "xer"
 				startArg = 1
 			case 8:
-				buf.WriteString("lr")
+				mn += "lr"
 				startArg = 1
 			case 9:
-				buf.WriteString("ctr")
+				mn += "ctr"
 				startArg = 1
 			default:
-				buf.WriteString("spr")
+				mn += "spr"
 			}
 		default:
-			buf.WriteString("spr")
+			mn += "spr"
 		}
+		buf.add(TokenMnemonic, mn)
 
 	case "mfspr":
 		opcode := inst.Op.String()
-		buf.WriteString(opcode[0:2])
+		mn := opcode[0:2]
 		arg := inst.Args[0]
 		switch spr := inst.Args[1].(type) {
 		case SpReg:
 			switch spr {
 			case 1:
-				buf.WriteString("xer ")
-				buf.WriteString(gnuArg(&inst, 0, arg, PC))
+				mn += "xer"
 				startArg = 2
 			case 8:
-				buf.WriteString("lr ")
-				buf.WriteString(gnuArg(&inst, 0, arg, PC))
+				mn += "lr"
 				startArg = 2
 			case 9:
-				buf.WriteString("ctr ")
-				buf.WriteString(gnuArg(&inst, 0, arg, PC))
+				mn += "ctr"
 				startArg = 2
 			case 268:
-				buf.WriteString("tb ")
-				buf.WriteString(gnuArg(&inst, 0, arg, PC))
+				mn += "tb"
 				startArg = 2
 			default:
-				buf.WriteString("spr")
+				mn += "spr"
 			}
 		default:
-			buf.WriteString("spr")
+			mn += "spr"
+		}
+		buf.add(TokenMnemonic, mn)
+		if startArg == 2 {
+			buf.text(" ")
+			buf.addAll(gnuArg(&inst, 0, arg, PC))
 		}
 
 	case "mtfsfi", "mtfsfi.":
-		buf.WriteString(opName)
+		buf.add(TokenMnemonic, opName)
 		l := inst.Args[2].(Imm)
 		if l == 0 {
 			// L == 0 is an extended mnemonic for the same.
-			asm := fmt.Sprintf(" %s,%s",
-				gnuArg(&inst, 0, inst.Args[0], PC),
-				gnuArg(&inst, 1, inst.Args[1], PC))
-			buf.WriteString(asm)
+			buf.gnuArgs(&inst, PC, 0, 1)
 			startArg = 3
 		}
 
 	case "paste.":
-		buf.WriteString(opName)
+		buf.add(TokenMnemonic, opName)
 		l := inst.Args[2].(Imm)
 		if l == 1 {
 			// L == 1 is an extended mnemonic for the same.
-			asm := fmt.Sprintf(" %s,%s",
-				gnuArg(&inst, 0, inst.Args[0], PC),
-				gnuArg(&inst, 1, inst.Args[1], PC))
-			buf.WriteString(asm)
+			buf.gnuArgs(&inst, PC, 0, 1)
 			startArg = 3
 		}
 
 	case "mtfsf", "mtfsf.":
-		buf.WriteString(opName)
+		buf.add(TokenMnemonic, opName)
 		l := inst.Args[3].(Imm)
 		if l == 0 {
 			// L == 0 is an extended mnemonic for the same.
-			asm := fmt.Sprintf(" %s,%s,%s",
-				gnuArg(&inst, 0, inst.Args[0], PC),
-				gnuArg(&inst, 1, inst.Args[1], PC),
-				gnuArg(&inst, 2, inst.Args[2], PC))
-			buf.WriteString(asm)
+			buf.gnuArgs(&inst, PC, 0, 1, 2)
 			startArg = 4
 		}
 
@@ -266,13 +261,13 @@ func GNUSyntax(inst Inst, pc uint64) string {
 		lsc := inst.Args[0].(Imm)<<4 | inst.Args[1].(Imm)
 		switch lsc {
 		case 0x00:
-			buf.WriteString("hwsync")
+			buf.add(TokenMnemonic, "hwsync")
 			startArg = 2
 		case 0x10:
-			buf.WriteString("lwsync")
+			buf.add(TokenMnemonic, "lwsync")
 			startArg = 2
 		default:
-			buf.WriteString(opName)
+			buf.add(TokenMnemonic, opName)
 		}
 
 	case "lbarx", "lharx", "lwarx", "ldarx":
@@ -281,7 +276,7 @@ func GNUSyntax(inst Inst, pc uint64) string {
 		if eh == 0 {
 			argList = inst.Args[:3]
 		}
-		buf.WriteString(inst.Op.String())
+		buf.add(TokenMnemonic, inst.Op.String())
 
 	case "paddi":
 		// There are several extended mnemonics.  Notably, "pla" is
@@ -289,27 +284,20 @@ func GNUSyntax(inst Inst, pc uint64) string {
 		// always be 0.  Otherwise it is invalid.
 		r := inst.Args[3].(Imm)
 		ra := inst.Args[1].(Reg)
-		str := opName
 		if ra == R0 {
 			name := []string{"pli", "pla"}
-			str = fmt.Sprintf("%s %s,%s",
-				name[r&1],
-				gnuArg(&inst, 0, inst.Args[0], PC),
-				gnuArg(&inst, 2, inst.Args[2], PC))
+			buf.add(TokenMnemonic, name[r&1])
+			buf.gnuArgs(&inst, PC, 0, 2)
 			startArg = 4
 		} else {
-			str = fmt.Sprintf("%s %s,%s,%s", opName,
-				gnuArg(&inst, 0, inst.Args[0], PC),
-				gnuArg(&inst, 1, inst.Args[1], PC),
-				gnuArg(&inst, 2, inst.Args[2], PC))
-			startArg = 4
 			if r == 1 {
 				// This is an illegal encoding (ra != 0 && r == 1) on ISA 3.1.
-				v := uint64(inst.Enc)<<32 | uint64(inst.SuffixEnc)
-				return fmt.Sprintf(".quad 0x%x", v)
+				return quadTokens(inst)
 			}
+			buf.add(TokenMnemonic, opName)
+			buf.gnuArgs(&inst, PC, 0, 1, 2)
+			startArg = 4
 		}
-		buf.WriteString(str)
 
 	default:
 		// Prefixed load/stores do not print the displacement register when R==1 (they are PCrel).
@@ -318,25 +306,23 @@ func GNUSyntax(inst Inst, pc uint64) string {
 			r := inst.Args[3].(Imm)
 			ra := inst.Args[2].(Reg)
 			d := inst.Args[1].(Offset)
-			if r == 1 && ra == R0 {
-				str := fmt.Sprintf("%s %s,%d", opName, gnuArg(&inst, 0, inst.Args[0], PC), d)
-				buf.WriteString(str)
-				startArg = 4
-			} else {
-				str := fmt.Sprintf("%s %s,%d(%s)", opName,
-					gnuArg(&inst, 0, inst.Args[0], PC),
-					d,
-					gnuArg(&inst, 2, inst.Args[2], PC))
-				if r == 1 {
-					// This is an invalid encoding (ra != 0 && r == 1) on ISA 3.1.
-					v := uint64(inst.Enc)<<32 | uint64(inst.SuffixEnc)
-					return fmt.Sprintf(".quad 0x%x", v)
-				}
-				buf.WriteString(str)
-				startArg = 4
+			if r == 1 && ra != R0 {
+				// This is an invalid encoding (ra != 0 && r == 1) on ISA 3.1.
+				return quadTokens(inst)
 			}
+			buf.add(TokenMnemonic, opName)
+			buf.text(" ")
+			buf.addAll(gnuArg(&inst, 0, inst.Args[0], PC))
+			buf.text(",")
+			buf.add(TokenImmediate, fmt.Sprintf("%d", d))
+			if r != 1 {
+				buf.text("(")
+				buf.addAll(gnuArg(&inst, 2, inst.Args[2], PC))
+				buf.text(")")
+			}
+			startArg = 4
 		} else {
-			buf.WriteString(opName)
+			buf.add(TokenMnemonic, opName)
 		}
 	}
 	for i, arg := range argList {
@@ -347,20 +333,40 @@ func GNUSyntax(inst Inst, pc uint64) string {
 			continue
 		}
 		text := gnuArg(&inst, i, arg, PC)
-		if text == "" {
+		if text == nil {
 			continue
 		}
-		buf.WriteString(sep)
+		buf.text(sep)
 		sep = ","
-		buf.WriteString(text)
+		buf.addAll(text)
 	}
-	return buf.String()
+	return buf
+}
+
+// gnuArgs adds the arguments of inst with the given indexes,
+// separated by commas and preceded by a space.
+func (ts *tokens) gnuArgs(inst *Inst, pc uint64, index ...int) {
+	for i, j := range index {
+		if i == 0 {
+			ts.text(" ")
+		} else {
+			ts.text(",")
+		}
+		ts.addAll(gnuArg(inst, j, inst.Args[j], pc))
+	}
+}
+
+// quadTokens returns the tokens of a prefixed instruction
+// shown as data, as binutils shows invalid encodings.
+func quadTokens(inst Inst) tokens {
+	v := uint64(inst.Enc)<<32 | uint64(inst.SuffixEnc)
+	return tokens{{TokenMnemonic, ".quad"}, {TokenText, " "}, {TokenImmediate, fmt.Sprintf("0x%x", v)}}
 }
 
 // gnuArg formats arg (which is the argIndex's arg in inst) according to GNU rules.
 // NOTE: because GNUSyntax is the only caller of this func, and it receives a copy
 // of inst, it's ok to modify inst.Args here.
-func gnuArg(inst *Inst, argIndex int, arg Arg, pc uint64) string {
+func gnuArg(inst *Inst, argIndex int, arg Arg, pc uint64) tokens {
 	// special cases for load/store instructions
 	if _, ok := arg.(Offset); ok {
 		if argIndex+1 == len(inst.Args) || inst.Args[argIndex+1] == nil {
@@ -370,58 +376,67 @@ func gnuArg(inst *Inst, argIndex int, arg Arg, pc uint64) string {
 	switch arg := arg.(type) {
 	case Reg:
 		if isLoadStoreOp(inst.Op) && argIndex == 1 && arg == R0 {
-			return "0"
+			return tok(TokenImmediate, "0")
 		}
-		return arg.String()
+		return tok(TokenRegister, arg.String())
 	case CondReg:
 		// The CondReg can either be found in a CMP, where the
 		// condition register field is being set, or in an instruction
 		// like a branch or isel that is testing a bit in a condition
 		// register field.
 		if arg == CR0 && strings.HasPrefix(inst.Op.String(), "cmp") {
-			return "" // don't show cr0 for cmp instructions
+			return nil // don't show cr0 for cmp instructions
 		} else if arg >= CR0 {
-			return fmt.Sprintf("cr%d", int(arg-CR0))
+			return tok(TokenRegister, fmt.Sprintf("cr%d", int(arg-CR0)))
 		}
 		bit := condBit[(arg-Cond0LT)%4]
 		if arg <= Cond0SO {
-			return bit
+			return tok(TokenRegister, bit)
+		}
+		return tokens{
+			{TokenImmediate, "4"}, {TokenText, "*"},
+			{TokenRegister, fmt.Sprintf("cr%d", int(arg-Cond0LT)/4)},
+			{TokenText, "+"}, {TokenRegister, bit},
 		}
-		return fmt.Sprintf("4*cr%d+%s", int(arg-Cond0LT)/4, bit)
 	case Imm:
-		return fmt.Sprintf("%d", arg)
+		return tok(TokenImmediate, fmt.Sprintf("%d", arg))
 	case SpReg:
 		switch int(arg) {
 		case 1:
-			return "xer"
+			return tok(TokenRegister, "xer")
 		case 8:
-			return "lr"
+			return tok(TokenRegister, "lr")
 		case 9:
-			return "ctr"
+			return tok(TokenRegister, "ctr")
 		case 268:
-			return "tb"
+			return tok(TokenRegister, "tb")
 		default:
-			return fmt.Sprintf("%d", int(arg))
+			return tok(TokenImmediate, fmt.Sprintf("%d", int(arg)))
 		}
 	case PCRel:
 		// If the arg is 0, use the relative address format.
 		// Otherwise the pc is meaningful, use absolute address.
 		if int(arg) == 0 {
-			return fmt.Sprintf(".%+#x", int(arg))
+			return tok(TokenAddress, fmt.Sprintf(".%+#x", int(arg)))
 		}
 		addr := pc + uint64(int64(arg))
-		return fmt.Sprintf("%#x", addr)
+		return tok(TokenAddress, fmt.Sprintf("%#x", addr))
 	case Label:
-		return fmt.Sprintf("%#x", uint32(arg))
+		return tok(TokenAddress, fmt.Sprintf("%#x", uint32(arg)))
 	case Offset:
 		reg := inst.Args[argIndex+1].(Reg)
 		removeArg(inst, argIndex+1)
+		ts := tok(TokenImmediate, fmt.Sprintf("%d", int(arg)))
+		ts.text("(")
 		if reg == R0 {
-			return fmt.Sprintf("%d(0)", int(arg))
+			ts.add(TokenImmediate, "0")
+		} else {
+			ts.add(TokenRegister, fmt.Sprintf("r%d", reg-R0))
 		}
-		return fmt.Sprintf("%d(r%d)", int(arg), reg-R0)
+		ts.text(")")
+		return ts
 	}
-	return fmt.Sprintf("???(%v)", arg)
+	return tok(TokenText, fmt.Sprintf("???(%v)", arg))
 }
 
 // removeArg removes the arg in inst.Args[index].
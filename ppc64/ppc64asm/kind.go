@@ -0,0 +1,126 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import "golang.org/x/arch/internal/asmarg"
+
+// An ArgKind classifies an instruction argument. The x86asm, armasm
+// and arm64asm packages share the same type and kinds.
+type ArgKind = asmarg.Kind
+
+const (
+	KindOther   = asmarg.Other   // none of the other kinds
+	KindReg     = asmarg.Reg     // general-purpose, floating-point or vector register
+	KindImm     = asmarg.Imm     // immediate constant
+	KindMem     = asmarg.Mem     // memory offset (Offset); the base register is the next argument
+	KindPCRel   = asmarg.PCRel   // PC-relative address
+	KindAddr    = asmarg.Addr    // absolute code address (Label)
+	KindRegList = asmarg.RegList // register list; not used on ppc64
+	KindCond    = asmarg.Cond    // condition register bit or field (CondReg)
+	KindSysReg  = asmarg.SysReg  // special-purpose register (SpReg)
+)
+
+// A RegRef describes a register, as RegValue returns it.
+// It is the same type in all the disassembler packages.
+type RegRef = asmarg.RegRef
+
+// A MemRef describes a memory reference, as MemValue returns it.
+// It is the same type in all the disassembler packages.
+type MemRef = asmarg.MemRef
+
+// Kind returns KindReg.
+func (Reg) Kind() ArgKind { return KindReg }
+
+// Kind returns KindCond.
+func (CondReg) Kind() ArgKind { return KindCond }
+
+// Kind returns KindSysReg.
+func (SpReg) Kind() ArgKind { return KindSysReg }
+
+// Kind returns KindPCRel.
+func (PCRel) Kind() ArgKind { return KindPCRel }
+
+// Kind returns KindAddr.
+func (Label) Kind() ArgKind { return KindAddr }
+
+// Kind returns KindImm.
+func (Imm) Kind() ArgKind { return KindImm }
+
+// Kind returns KindMem.
+func (Offset) Kind() ArgKind { return KindMem }
+
+// ArgKindOf returns the kind of a, as its Kind method reports it.
+// It returns KindOther for nil and for an Arg implementation
+// outside this package that has no Kind method.
+func ArgKindOf(a Arg) ArgKind {
+	if k, ok := a.(interface{ Kind() ArgKind }); ok {
+		return k.Kind()
+	}
+	return KindOther
+}
+
+// ImmValue returns the value of a, if a is an immediate constant.
+func ImmValue(a Arg) (int64, bool) {
+	if i, ok := a.(Imm); ok {
+		return int64(i), true
+	}
+	return 0, false
+}
+
+// RegValue returns the register a, if a is a register.
+// A special-purpose register has its SPR number as Num.
+func RegValue(a Arg) (RegRef, bool) {
+	switch a := a.(type) {
+	case Reg:
+		ref := RegRef{Name: a.String(), Num: -1}
+		switch {
+		case R0 <= a && a <= R31:
+			ref.Num = int(a - R0)
+		case F0 <= a && a <= F31:
+			ref.Num = int(a - F0)
+		case V0 <= a && a <= V31:
+			ref.Num = int(a - V0)
+		case VS0 <= a && a <= VS63:
+			ref.Num = int(a - VS0)
+		case A0 <= a && a <= A7:
+			ref.Num = int(a - A0)
+		}
+		return ref, true
+	case SpReg:
+		return RegRef{Name: a.String(), Num: int(a)}, true
+	}
+	return RegRef{}, false
+}
+
+// MemValue returns the memory reference made by inst.Args[i], if
+// that argument is an Offset. The base register is inst.Args[i+1];
+// as a base, r0 means no register and MemRef.Base is then empty.
+func MemValue(inst Inst, i int) (MemRef, bool) {
+	off, ok := inst.Args[i].(Offset)
+	if !ok || i+1 >= len(inst.Args) {
+		return MemRef{}, false
+	}
+	base, ok := inst.Args[i+1].(Reg)
+	if !ok {
+		return MemRef{}, false
+	}
+	ref := MemRef{Disp: int64(off)}
+	if base != R0 {
+		ref.Base = base.String()
+	}
+	return ref, true
+}
+
+// PCRelTarget returns the address that the PC-relative argument
+// of inst refers to, for inst located at pc. The boolean result
+// reports whether inst has a PC-relative argument.
+func PCRelTarget(inst Inst, pc uint64) (uint64, bool) {
+	for _, a := range inst.Args {
+		if r, ok := a.(PCRel); ok {
+			return pc + uint64(int64(r)), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestArgKindOf(t *testing.T) {
+	tests := []struct {
+		enc   uint32
+		kinds string
+		imm   int64
+	}{
+		{0x38630005, "Reg Reg Imm", 5},     // addi r3,r3,5
+		{0xe8820010, "Reg Mem Reg", 0},     // ld r4,16(r2)
+		{0x7c0802a6, "Reg SysReg", 0},      // mflr r0
+		{0x41820008, "Imm Cond PCRel", 12}, // beq .+8
+		{0x48000102, "Addr", 0},            // ba 0x100
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.BigEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:], binary.BigEndian)
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		var kinds []string
+		for _, a := range inst.Args {
+			if a == nil {
+				break
+			}
+			kinds = append(kinds, ArgKindOf(a).String())
+			if v, ok := ImmValue(a); ok && v != tt.imm {
+				t.Errorf("%v: ImmValue(%v) = %d, want %d", inst, a, v, tt.imm)
+			}
+		}
+		if s := strings.Join(kinds, " "); s != tt.kinds {
+			t.Errorf("%v: kinds %q, want %q", inst, s, tt.kinds)
+		}
+	}
+}
+
+func TestArgValues(t *testing.T) {
+	tests := []struct {
+		enc uint32
+		i   int
+		reg RegRef
+		mem MemRef
+	}{
+		{0xe8820010, 0, RegRef{Name: "r4", Num: 4}, MemRef{}}, // ld r4,16(r2)
+		{0xe8820010, 1, RegRef{}, MemRef{Base: "r2", Disp: 16}},
+		{0xe880fff8, 1, RegRef{}, MemRef{Disp: -8}},                 // ld r4,-8(0)
+		{0x7c0802a6, 1, RegRef{Name: "SpReg(8)", Num: 8}, MemRef{}}, // mflr r0
+		{0xfc011028, 2, RegRef{Name: "f2", Num: 2}, MemRef{}},       // fsub f0,f1,f2
+		{0x41820008, 1, RegRef{}, MemRef{}},                         // beq .+8
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.BigEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:], binary.BigEndian)
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		reg, ok := RegValue(inst.Args[tt.i])
+		if ok != (tt.reg != RegRef{}) || reg != tt.reg {
+			t.Errorf("%v: RegValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, reg, ok, tt.reg)
+		}
+		mem, ok := MemValue(inst, tt.i)
+		if ok != (tt.mem != MemRef{}) || mem != tt.mem {
+			t.Errorf("%v: MemValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, mem, ok, tt.mem)
+		}
+	}
+}
+
+func TestPCRelTarget(t *testing.T) {
+	tests := []struct {
+		enc    uint32
+		target uint64
+		ok     bool
+	}{
+		{0x41820008, 0x1008, true}, // beq .+8
+		{0x4bfffffd, 0x0ffc, true}, // bl .-4
+		{0x4e800020, 0, false},     // blr
+	}
+	for _, tt := range tests {
+		var b [4]byte
+		binary.BigEndian.PutUint32(b[:], tt.enc)
+		inst, err := Decode(b[:], binary.BigEndian)
+		if err != nil {
+			t.Fatalf("Decode(%#08x): %v", tt.enc, err)
+		}
+		if target, ok := PCRelTarget(inst, 0x1000); target != tt.target || ok != tt.ok {
+			t.Errorf("%v: PCRelTarget = %#x, %v, want %#x, %v", inst, target, ok, tt.target, tt.ok)
+		}
+	}
+}
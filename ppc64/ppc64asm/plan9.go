@@ -16,20 +16,24 @@ import (
 // being disassembled. It returns the name and base address of the symbol
 // containing the target, if any; otherwise it returns "", 0.
 func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) string {
+	return goTokens(inst, pc, symname).String()
+}
+
+func goTokens(inst Inst, pc uint64, symname func(uint64) (string, uint64)) tokens {
 	if symname == nil {
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
 	if inst.Op == 0 && inst.Enc == 0 {
-		return "WORD $0"
+		return tokens{{TokenMnemonic, "WORD"}, {TokenText, " "}, {TokenImmediate, "$0"}}
 	} else if inst.Op == 0 {
-		return "?"
+		return tok(TokenText, "?")
 	}
-	var args []string
+	var args []tokens
 	for i, a := range inst.Args[:] {
 		if a == nil {
 			break
 		}
-		if s := plan9Arg(&inst, i, pc, a, symname); s != "" {
+		if s := plan9Arg(&inst, i, pc, a, symname); s != nil {
 			args = append(args, s)
 		}
 	}
@@ -46,36 +50,36 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) strin
 	default: // dst, sA, sB, ...
 		switch len(args) {
 		case 0:
-			return op
+			return tok(TokenMnemonic, op)
 		case 1:
-			return fmt.Sprintf("%s %s", op, args[0])
+			return opTokens(op, ",", args[0])
 		case 2:
 			if inst.Op == COPY || inst.Op == PASTECC {
-				return op + " " + args[0] + "," + args[1]
+				return opTokens(op, ",", args[0], args[1])
 			}
-			return op + " " + args[1] + "," + args[0]
+			return opTokens(op, ",", args[1], args[0])
 		case 3:
 			if reverseOperandOrder(inst.Op) {
-				return op + " " + args[2] + "," + args[1] + "," + args[0]
+				return opTokens(op, ",", args[2], args[1], args[0])
 			}
 		case 4:
 			if reverseMiddleOps(inst.Op) {
-				return op + " " + args[1] + "," + args[3] + "," + args[2] + "," + args[0]
+				return opTokens(op, ",", args[1], args[3], args[2], args[0])
 			}
 		}
 		args = append(args, args[0])
-		return op + " " + strings.Join(args[1:], ",")
+		return opTokens(op, ",", args[1:]...)
 	case PASTECC:
 		// paste. has two input registers, and an L field, unlike other 3 operand instructions.
-		return op + " " + args[0] + "," + args[1] + "," + args[2]
+		return opTokens(op, ",", args[0], args[1], args[2])
 	case SYNC:
-		if args[0] == "$1" {
-			return "LWSYNC"
+		if args[0].String() == "$1" {
+			return tok(TokenMnemonic, "LWSYNC")
 		}
-		return "HWSYNC"
+		return tok(TokenMnemonic, "HWSYNC")
 
 	case ISEL:
-		return "ISEL " + args[3] + "," + args[1] + "," + args[2] + "," + args[0]
+		return opTokens("ISEL", ",", args[3], args[1], args[2], args[0])
 
 	// store instructions always have the memory operand at the end, no need to reorder
 	// indexed stores handled separately
@@ -86,94 +90,122 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) strin
 		STFD, STFDU,
 		STFS, STFSU,
 		STQ, HASHST, HASHSTP:
-		return op + " " + strings.Join(args, ",")
+		return opTokens(op, ",", args...)
 
 	case FCMPU, FCMPO, CMPD, CMPDI, CMPLD, CMPLDI, CMPW, CMPWI, CMPLW, CMPLWI:
 		crf := int(inst.Args[0].(CondReg) - CR0)
-		cmpstr := op + " " + args[1] + "," + args[2]
 		if crf != 0 { // print CRx as the final operand if not implied (i.e BF != 0)
-			cmpstr += "," + args[0]
+			return opTokens(op, ",", args[1], args[2], args[0])
 		}
-		return cmpstr
+		return opTokens(op, ",", args[1], args[2])
 
 	case LIS:
-		return "ADDIS $0," + args[1] + "," + args[0]
+		return opTokens("ADDIS", ",", tok(TokenImmediate, "$0"), args[1], args[0])
 	// store instructions with index registers
 	case STBX, STBUX, STHX, STHUX, STWX, STWUX, STDX, STDUX,
 		STHBRX, STWBRX, STDBRX, STSWX, STFIWX:
-		return "MOV" + op[2:len(op)-1] + " " + args[0] + ",(" + args[2] + ")(" + args[1] + ")"
+		return opTokens("MOV"+op[2:len(op)-1], ",", args[0], indexed(args[2], args[1]))
 
 	case STDCXCC, STWCXCC, STHCXCC, STBCXCC:
-		return op + " " + args[0] + ",(" + args[2] + ")(" + args[1] + ")"
+		return opTokens(op, ",", args[0], indexed(args[2], args[1]))
 
 	case STXVX, STXVD2X, STXVW4X, STXVH8X, STXVB16X, STXSDX, STVX, STVXL, STVEBX, STVEHX, STVEWX, STXSIWX, STFDX, STFDUX, STFDPX, STFSX, STFSUX:
-		return op + " " + args[0] + ",(" + args[2] + ")(" + args[1] + ")"
+		return opTokens(op, ",", args[0], indexed(args[2], args[1]))
 
 	case STXV:
-		return op + " " + args[0] + "," + args[1]
+		return opTokens(op, ",", args[0], args[1])
 
 	case STXVL, STXVLL:
-		return op + " " + args[0] + "," + args[1] + "," + args[2]
+		return opTokens(op, ",", args[0], args[1], args[2])
 
 	case LWAX, LWAUX, LWZX, LHZX, LBZX, LDX, LHAX, LHAUX, LDARX, LWARX, LHARX, LBARX, LFDX, LFDUX, LFSX, LFSUX, LDBRX, LWBRX, LHBRX, LDUX, LWZUX, LHZUX, LBZUX:
-		if args[1] == "0" {
-			return op + " (" + args[2] + ")," + args[0]
+		if args[1].String() == "0" {
+			return opTokens(op, ",", indexed(args[2], nil), args[0])
 		}
-		return op + " (" + args[2] + ")(" + args[1] + ")," + args[0]
+		return opTokens(op, ",", indexed(args[2], args[1]), args[0])
 
 	case LXVX, LXVD2X, LXVW4X, LXVH8X, LXVB16X, LVX, LVXL, LVSR, LVSL, LVEBX, LVEHX, LVEWX, LXSDX, LXSIWAX:
-		return op + " (" + args[2] + ")(" + args[1] + ")," + args[0]
+		return opTokens(op, ",", indexed(args[2], args[1]), args[0])
 
 	case LXV:
-		return op + " " + args[1] + "," + args[0]
+		return opTokens(op, ",", args[1], args[0])
 
 	case LXVL, LXVLL:
-		return op + " " + args[1] + "," + args[2] + "," + args[0]
+		return opTokens(op, ",", args[1], args[2], args[0])
 
 	case DCBT, DCBTST, DCBZ, DCBST, ICBI:
-		if args[0] == "0" || args[0] == "R0" {
-			return op + " (" + args[1] + ")"
+		if a := args[0].String(); a == "0" || a == "R0" {
+			return opTokens(op, ",", indexed(args[1], nil))
 		}
-		return op + " (" + args[1] + ")(" + args[0] + ")"
+		return opTokens(op, ",", indexed(args[1], args[0]))
 
 	// branch instructions needs additional handling
 	case BCLR:
 		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
-			return "RET"
+			return tok(TokenMnemonic, "RET")
 		}
-		return op + " " + strings.Join(args, ", ")
+		return opTokens(op, ", ", args...)
 	case BC:
 		bo := int(inst.Args[0].(Imm))
 		bi := int(inst.Args[1].(CondReg) - Cond0LT)
 		bcname := condName[((bo&0x8)>>1)|(bi&0x3)]
 		if bo&0x17 == 4 { // jump only a CR bit set/unset, no hints (at bits) set.
 			if bi >= 4 {
-				return fmt.Sprintf("B%s CR%d,%s", bcname, bi>>2, args[2])
+				return opTokens("B"+bcname, ",", tok(TokenRegister, fmt.Sprintf("CR%d", bi>>2)), args[2])
 			} else {
-				return fmt.Sprintf("B%s %s", bcname, args[2])
+				return opTokens("B"+bcname, ",", args[2])
 			}
 		}
-		return op + " " + strings.Join(args, ",")
+		return opTokens(op, ",", args...)
 	case BCCTR:
 		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
-			return "BR (CTR)"
+			return opTokens("BR", ",", indexed(tok(TokenRegister, "CTR"), nil))
 		}
-		return op + " " + strings.Join(args, ", ")
+		return opTokens(op, ", ", args...)
 	case BCCTRL:
 		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
-			return "BL (CTR)"
+			return opTokens("BL", ",", indexed(tok(TokenRegister, "CTR"), nil))
 		}
-		return op + " " + strings.Join(args, ",")
+		return opTokens(op, ",", args...)
 	case BCA, BCL, BCLA, BCLRL, BCTAR, BCTARL:
-		return op + " " + strings.Join(args, ",")
+		return opTokens(op, ",", args...)
 	}
 }
 
+// opTokens returns the tokens of the mnemonic op followed by args
+// separated by sep.
+func opTokens(op, sep string, args ...tokens) tokens {
+	ts := tok(TokenMnemonic, op)
+	for i, a := range args {
+		if i == 0 {
+			ts.text(" ")
+		} else {
+			ts.text(sep)
+		}
+		ts.addAll(a)
+	}
+	return ts
+}
+
+// indexed returns the tokens of the memory operand (base)(index),
+// or (base) if index is nil.
+func indexed(base, index tokens) tokens {
+	ts := tok(TokenText, "(")
+	ts.addAll(base)
+	ts.text(")")
+	if index != nil {
+		ts.text("(")
+		ts.addAll(index)
+		ts.text(")")
+	}
+	return ts
+}
+
 // plan9Arg formats arg (which is the argIndex's arg in inst) according to Plan 9 rules.
 //
 // NOTE: because Plan9Syntax is the only caller of this func, and it receives a copy
 // of inst, it's ok to modify inst.Args here.
-func plan9Arg(inst *Inst, argIndex int, pc uint64, arg Arg, symname func(uint64) (string, uint64)) string {
+func plan9Arg(inst *Inst, argIndex int, pc uint64, arg Arg, symname func(uint64) (string, uint64)) tokens {
 	// special cases for load/store instructions
 	if _, ok := arg.(Offset); ok {
 		if argIndex+1 == len(inst.Args) || inst.Args[argIndex+1] == nil {
@@ -183,59 +215,64 @@ func plan9Arg(inst *Inst, argIndex int, pc uint64, arg Arg, symname func(uint64)
 	switch arg := arg.(type) {
 	case Reg:
 		if isLoadStoreOp(inst.Op) && argIndex == 1 && arg == R0 {
-			return "0"
+			return tok(TokenImmediate, "0")
 		}
 		if arg == R30 {
-			return "g"
+			return tok(TokenRegister, "g")
 		}
-		return strings.ToUpper(arg.String())
+		return tok(TokenRegister, strings.ToUpper(arg.String()))
 	case CondReg:
 		// This op is left as its numerical value, not mapped onto CR + condition
 		if inst.Op == ISEL {
-			return fmt.Sprintf("$%d", (arg - Cond0LT))
+			return tok(TokenImmediate, fmt.Sprintf("$%d", (arg-Cond0LT)))
 		}
 		bit := [4]string{"LT", "GT", "EQ", "SO"}[(arg-Cond0LT)%4]
 		if arg <= Cond0SO {
-			return bit
+			return tok(TokenRegister, bit)
 		} else if arg > Cond0SO && arg <= Cond7SO {
-			return fmt.Sprintf("CR%d%s", int(arg-Cond0LT)/4, bit)
+			return tok(TokenRegister, fmt.Sprintf("CR%d%s", int(arg-Cond0LT)/4, bit))
 		} else {
-			return fmt.Sprintf("CR%d", int(arg-CR0))
+			return tok(TokenRegister, fmt.Sprintf("CR%d", int(arg-CR0)))
 		}
 	case Imm:
-		return fmt.Sprintf("$%d", arg)
+		return tok(TokenImmediate, fmt.Sprintf("$%d", arg))
 	case SpReg:
 		switch arg {
 		case 8:
-			return "LR"
+			return tok(TokenRegister, "LR")
 		case 9:
-			return "CTR"
+			return tok(TokenRegister, "CTR")
 		}
-		return fmt.Sprintf("SPR(%d)", int(arg))
+		return tokens{{TokenText, "SPR("}, {TokenImmediate, fmt.Sprintf("%d", int(arg))}, {TokenText, ")"}}
 	case PCRel:
 		addr := pc + uint64(int64(arg))
 		s, base := symname(addr)
 		if s != "" && addr == base {
-			return fmt.Sprintf("%s(SB)", s)
+			return tokens{{TokenSymbol, s}, {TokenText, "(SB)"}}
 		}
 		if inst.Op == BL && s != "" && (addr-base) == 8 {
 			// When decoding an object built for PIE, a CALL targeting
 			// a global entry point will be adjusted to the local entry
 			// if any. For now, assume any symname+8 PC is a local call.
-			return fmt.Sprintf("%s+%d(SB)", s, addr-base)
+			return tokens{{TokenSymbol, s}, {TokenImmediate, fmt.Sprintf("+%d", addr-base)}, {TokenText, "(SB)"}}
 		}
-		return fmt.Sprintf("%#x", addr)
+		return tok(TokenAddress, fmt.Sprintf("%#x", addr))
 	case Label:
-		return fmt.Sprintf("%#x", int(arg))
+		return tok(TokenAddress, fmt.Sprintf("%#x", int(arg)))
 	case Offset:
 		reg := inst.Args[argIndex+1].(Reg)
 		removeArg(inst, argIndex+1)
+		ts := tok(TokenImmediate, fmt.Sprintf("%d", int(arg)))
+		ts.text("(")
 		if reg == R0 {
-			return fmt.Sprintf("%d(0)", int(arg))
+			ts.add(TokenImmediate, "0")
+		} else {
+			ts.add(TokenRegister, fmt.Sprintf("R%d", reg-R0))
 		}
-		return fmt.Sprintf("%d(R%d)", int(arg), reg-R0)
+		ts.text(")")
+		return ts
 	}
-	return fmt.Sprintf("???(%v)", arg)
+	return tok(TokenText, fmt.Sprintf("???(%v)", arg))
 }
 
 func reverseMiddleOps(op Op) bool {
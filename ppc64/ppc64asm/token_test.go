@@ -0,0 +1,77 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func joinTokens(toks []Token) string {
+	var b strings.Builder
+	for _, t := range toks {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+func TestSyntaxTokens(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		if addr >= 0x100000 && addr < 0x100010 {
+			return "runtime.foo", 0x100000
+		}
+		return "", 0
+	}
+	var tests = []struct {
+		enc    string
+		syntax string
+		want   []Token
+	}{
+		{"e8610008", "gnu", []Token{
+			{TokenMnemonic, "ld"}, {TokenText, " "}, {TokenRegister, "r3"}, {TokenText, ","},
+			{TokenImmediate, "8"}, {TokenText, "("}, {TokenRegister, "r1"}, {TokenText, ")"},
+		}},
+		{"e8610008", "plan9", []Token{
+			{TokenMnemonic, "MOVD"}, {TokenText, " "}, {TokenImmediate, "8"}, {TokenText, "("},
+			{TokenRegister, "R1"}, {TokenText, "),"}, {TokenRegister, "R3"},
+		}},
+		{"41860010", "gnu", []Token{
+			{TokenMnemonic, "beq"}, {TokenText, " "}, {TokenRegister, "cr1"}, {TokenText, ","},
+			{TokenAddress, "0x100010"},
+		}},
+		{"48000001", "plan9", []Token{
+			{TokenMnemonic, "CALL"}, {TokenText, " "}, {TokenSymbol, "runtime.foo"}, {TokenText, "(SB)"},
+		}},
+		{"48000009", "plan9", []Token{
+			{TokenMnemonic, "CALL"}, {TokenText, " "}, {TokenSymbol, "runtime.foo"}, {TokenImmediate, "+8"},
+			{TokenText, "(SB)"},
+		}},
+	}
+	for _, tt := range tests {
+		code, err := hex.DecodeString(tt.enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := Decode(code, binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%s): %v", tt.enc, err)
+			continue
+		}
+		var toks []Token
+		emit := func(t Token) { toks = append(toks, t) }
+		pc := uint64(0x100000)
+		if tt.syntax == "gnu" {
+			GNUSyntaxTokens(inst, pc, emit)
+		} else {
+			GoSyntaxTokens(inst, pc, symname, emit)
+		}
+		if !reflect.DeepEqual(toks, tt.want) {
+			t.Errorf("%s [%s] tokens = %v, want %v", tt.enc, tt.syntax, toks, tt.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// The json and csv output formats describe the instruction table in
+// a form meant for programs not written in Go and for comparing the
+// tables of different releases. They are the same formats written by
+// x86map and armmap.
+//
+// The json format is an array with one object per instruction form,
+// with these fields:
+//
+//	op - opcode name, as in the Op constants of ppc64asm
+//	syntax - assembly syntax from the manual
+//	encoding - bit fields of the encoding, as in the CSV
+//	mask, value - the form matches an instruction word w when w&mask == value,
+//		as hexadecimal strings; for prefixed instructions, w is
+//		the prefix word in the high 32 bits and the suffix word in
+//		the low 32 bits
+//	operands - operand names from the manual, in syntax order
+//	extension - ISA level that introduced the instruction
+//	privileged - whether the instruction can only be executed
+//		by the operating system or hypervisor
+//
+// The csv format has a header line naming the same fields, in the same
+// order, followed by one line per instruction form. Operands are
+// separated by semicolons.
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// An exportInst is one instruction form in the json and csv formats.
+type exportInst struct {
+	Op         string   `json:"op"`
+	Syntax     string   `json:"syntax"`
+	Encoding   string   `json:"encoding"`
+	Mask       string   `json:"mask,omitempty"`
+	Value      string   `json:"value,omitempty"`
+	Operands   []string `json:"operands"`
+	Extension  string   `json:"extension,omitempty"`
+	Privileged bool     `json:"privileged"`
+}
+
+// privileged lists the instructions that are privileged or
+// hypervisor privileged in Book III of the ISA.
+var privileged = map[string]bool{
+	"hashchkp": true,
+	"hashstp":  true,
+	"hrfid":    true,
+	"lbzcix":   true,
+	"ldcix":    true,
+	"lhzcix":   true,
+	"lwzcix":   true,
+	"mfmsr":    true,
+	"msgclr":   true,
+	"msgclrp":  true,
+	"msgsnd":   true,
+	"msgsndp":  true,
+	"msgsync":  true,
+	"mtmsr":    true,
+	"mtmsrd":   true,
+	"rfid":     true,
+	"rfscv":    true,
+	"slbfee.":  true,
+	"slbia":    true,
+	"slbiag":   true,
+	"slbie":    true,
+	"slbieg":   true,
+	"slbmfee":  true,
+	"slbmfev":  true,
+	"slbmte":   true,
+	"slbsync":  true,
+	"stbcix":   true,
+	"stdcix":   true,
+	"sthcix":   true,
+	"stop":     true,
+	"stwcix":   true,
+	"tlbie":    true,
+	"tlbiel":   true,
+	"tlbsync":  true,
+	"urfid":    true,
+}
+
+// printExport implements the -fmt=json and -fmt=csv modes.
+func printExport(p *Prog) {
+	isaName := make(map[isaversion]string)
+	for name, isa := range isaToISA {
+		isaName[isa] = name
+	}
+	var insts []exportInst
+	for _, inst := range p.Insts {
+		e := exportInst{
+			Op:         opName(inst.Op),
+			Syntax:     inst.Encoding,
+			Encoding:   inst.Layout,
+			Mask:       fmt.Sprintf("%#08x", inst.Mask),
+			Value:      fmt.Sprintf("%#08x", inst.Value),
+			Operands:   []string{},
+			Extension:  isaName[inst.Isa],
+			Privileged: privileged[inst.Op],
+		}
+		if inst.SMask != 0 {
+			e.Mask = fmt.Sprintf("%#016x", uint64(inst.Mask)<<32|uint64(inst.SMask))
+			e.Value = fmt.Sprintf("%#016x", uint64(inst.Value)<<32|uint64(inst.SValue))
+		}
+		for _, f := range inst.Fields {
+			e.Operands = append(e.Operands, f.Name)
+		}
+		insts = append(insts, e)
+	}
+	if err := writeExport(os.Stdout, *format, insts); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeExport writes insts to w in the given format, json or csv.
+func writeExport(w io.Writer, format string, insts []exportInst) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "\t")
+		return enc.Encode(insts)
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"op", "syntax", "encoding", "mask", "value", "operands", "extension", "privileged"})
+		for _, inst := range insts {
+			cw.Write([]string{inst.Op, inst.Syntax, inst.Encoding, inst.Mask, inst.Value, strings.Join(inst.Operands, ";"), inst.Extension, strconv.FormatBool(inst.Privileged)})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
@@ -16,6 +16,10 @@
 //		  go obj.Progs into machine code
 //	asm - generate a gnu asm file which can be compiled by gcc containing
 //	      all opcodes discovered in ppc64.csv using macro friendly arguments.
+//	json - print the instruction table as JSON, for use outside Go
+//	csv - print the instruction table as CSV, for use outside Go
+//
+// The json and csv formats are described in export.go.
 package main
 
 import (
@@ -35,7 +39,7 @@ import (
 	"text/template"
 )
 
-var format = flag.String("fmt", "text", "output format: text, decoder, asm")
+var format = flag.String("fmt", "text", "output format: text, decoder, encoder, asm, json, csv")
 var debug = flag.Bool("debug", false, "enable debugging output")
 
 var inputFile string
@@ -81,6 +85,18 @@ var isaToISA = map[string]isaversion{
 	"v3.1B": ISA_V31B,
 }
 
+// isaConstName returns the name of the ppc64asm constant for isa,
+// such as isaV31 for ISA_V31.
+func isaConstName(isa isaversion) string {
+	for name, v := range isaToISA {
+		if v == isa {
+			return "isa" + strings.NewReplacer("v", "V", ".", "").Replace(name)
+		}
+	}
+	log.Fatalf("unknown ISA version %d", isa)
+	return ""
+}
+
 func usage() {
 	fmt.Fprintf(os.Stderr, "usage: ppc64map [-fmt=format] ppc64.csv\n")
 	os.Exit(2)
@@ -110,6 +126,8 @@ func main() {
 		print = printASM
 	case "encoder":
 		print = printEncoder
+	case "json", "csv":
+		print = printExport
 	}
 
 	p, err := readCSV(flag.Arg(0))
@@ -170,6 +188,7 @@ func (f Field) String() string {
 type Inst struct {
 	Text      string
 	Encoding  string
+	Layout    string // Bit fields of the encoding, as in the CSV.
 	Op        string
 	Mask      uint32
 	Value     uint32
@@ -393,6 +412,7 @@ func add(p *Prog, text, mnemonics, encoding, isa string) {
 	var pmask, pvalue, presv, resv uint32
 	iword := int8(0)
 	ispfx := false
+	layout := encoding
 
 	isaLevel, fnd := isaToISA[isa]
 	if !fnd {
@@ -454,9 +474,9 @@ func add(p *Prog, text, mnemonics, encoding, isa string) {
 			value |= uint32(v) << args[i].Shift()
 			args.Delete(i)
 		}
-		inst := Inst{Text: text, Encoding: parts[1], Value: value, Mask: mask, DontCare: dontCare}
+		inst := Inst{Text: text, Encoding: parts[1], Layout: layout, Value: value, Mask: mask, DontCare: dontCare}
 		if ispfx {
-			inst = Inst{Text: text, Encoding: parts[1], Value: pvalue, Mask: pmask, DontCare: presv, SValue: value, SMask: mask, SDontCare: resv}
+			inst = Inst{Text: text, Encoding: parts[1], Layout: layout, Value: pvalue, Mask: pmask, DontCare: presv, SValue: value, SMask: mask, SDontCare: resv}
 		}
 
 		// order inst.Args according to mnemonics order
@@ -1316,7 +1336,7 @@ func printDecoder(p *Prog) {
 	for _, inst := range p.Insts {
 		m, v, dc := uint64(inst.Mask)<<32, uint64(inst.Value)<<32, uint64(inst.DontCare)<<32
 		m, v, dc = uint64(inst.SMask)|m, uint64(inst.SValue)|v, uint64(inst.SDontCare)|dc
-		fmt.Fprintf(&buf, "\t{ %s, %#x, %#x, %#x,", opName(inst.Op), m, v, dc)
+		fmt.Fprintf(&buf, "\t{ %s, %#x, %#x, %#x, %s,", opName(inst.Op), m, v, dc, isaConstName(inst.Isa))
 		fmt.Fprintf(&buf, " // %s (%s)\n\t\t[6]*argField{", inst.Text, inst.Encoding)
 		for _, f := range inst.Fields {
 			fmt.Fprintf(&buf, "%s, ", argFieldName(f))
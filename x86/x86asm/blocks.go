@@ -0,0 +1,219 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// A Block is a basic block: a run of instructions that is entered
+// only at its first instruction and left only after its last.
+type Block struct {
+	Start, End uint64 // addresses of the block's first byte and the byte after it
+	ID         uint32 // identifier derived from Start; see BlockID
+}
+
+// An Edge is a control-flow edge from the end of one block to the
+// start of another.
+type Edge struct {
+	From, To uint64 // start addresses of the blocks
+	ID       uint32 // identifier, BlockID(From)>>1 ^ BlockID(To)
+}
+
+// BlockID returns the identifier of the block starting at addr.
+// Like the random block identifiers of AFL-style fuzzers, block
+// identifiers are spread evenly over 32 bits, but they depend only
+// on the address, so that instrumentation and analysis agree on them
+// without sharing a table. Edge identifiers combine them as AFL does,
+// so that an edge and its reverse have different identifiers.
+func BlockID(addr uint64) uint32 {
+	// The finalizer of MurmurHash3's 64-bit variant.
+	addr ^= addr >> 33
+	addr *= 0xff51afd7ed558ccd
+	addr ^= addr >> 33
+	addr *= 0xc4ceb9fe1a85ec53
+	addr ^= addr >> 33
+	return uint32(addr)
+}
+
+// Blocks divides code, located at address pc, into basic blocks by
+// decoding it from start to end, and returns the blocks in address
+// order and the edges between them.
+//
+// A block ends at a jump, return or other instruction after which
+// execution does not simply continue, and before an instruction that
+// a direct jump or call in code targets. Calls do not end blocks:
+// the blocks are those of a function's control-flow graph. Edges are
+// the taken and fall-through paths of conditional jumps, the targets
+// of direct unconditional jumps and the fall-through from a block
+// ending only because the next instruction is a jump target or at a
+// system call or software interrupt, which return to the next
+// instruction. Targets
+// outside code, and those of indirect jumps, have no edges. Bytes
+// that do not decode end the block before them and are left out of
+// every block.
+func Blocks(code []byte, pc uint64, mode int) ([]Block, []Edge, error) {
+	if mode != 16 && mode != 32 && mode != 64 {
+		return nil, nil, ErrInvalidMode
+	}
+
+	// Decode every instruction, recording where they start.
+	type instInfo struct {
+		addr   uint64
+		len    int // 0 for undecodable bytes
+		op     Op
+		target uint64 // direct jump or call target
+		direct bool
+	}
+	var insts []instInfo
+	starts := make(map[uint64]bool)
+	for i := 0; i < len(code); {
+		addr := pc + uint64(i)
+		inst, err := Decode(code[i:], mode)
+		if err != nil || inst.Op == 0 {
+			insts = append(insts, instInfo{addr: addr})
+			i++
+			continue
+		}
+		info := instInfo{addr: addr, len: inst.Len, op: inst.Op}
+		if rel, ok := inst.Args[0].(Rel); ok {
+			info.target = addr + uint64(inst.Len) + uint64(int64(rel))
+			info.direct = true
+		}
+		insts = append(insts, info)
+		starts[addr] = true
+		i += inst.Len
+	}
+
+	// Find the leaders: instructions that start blocks.
+	leaders := make(map[uint64]bool)
+	leaders[pc] = true
+	for i, in := range insts {
+		if in.direct && starts[in.target] {
+			leaders[in.target] = true
+		}
+		if (in.len == 0 || blockEnd(in.op)) && i+1 < len(insts) {
+			leaders[insts[i+1].addr] = true
+		}
+	}
+
+	var blocks []Block
+	var edges []Edge
+	for i := 0; i < len(insts); {
+		if insts[i].len == 0 {
+			i++
+			continue
+		}
+		b := Block{Start: insts[i].addr, ID: BlockID(insts[i].addr)}
+		j := i
+		for j+1 < len(insts) && insts[j+1].len != 0 && !leaders[insts[j+1].addr] && !blockEnd(insts[j].op) {
+			j++
+		}
+		last := insts[j]
+		b.End = last.addr + uint64(last.len)
+		blocks = append(blocks, b)
+
+		edge := func(to uint64) {
+			edges = append(edges, Edge{b.Start, to, BlockID(b.Start)>>1 ^ BlockID(to)})
+		}
+		next := j+1 < len(insts) && insts[j+1].len != 0
+		switch {
+		case FlowOf(last.op) == FlowCondJump:
+			if last.direct && starts[last.target] {
+				edge(last.target)
+			}
+			if next {
+				edge(b.End)
+			}
+		case last.op == JMP:
+			if last.direct && starts[last.target] {
+				edge(last.target)
+			}
+		case !blockEnd(last.op) || resumes(last.op):
+			if next {
+				edge(b.End)
+			}
+		}
+		i = j + 1
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return blocks, edges, nil
+}
+
+// blockEnd reports whether an instruction with the given op ends
+// its basic block: every control transfer but calls and XABORT and
+// XEND, which continue with the next instruction outside a transaction.
+func blockEnd(op Op) bool {
+	switch FlowOf(op) {
+	case FlowNext, FlowCall, FlowAbort:
+		return false
+	}
+	return true
+}
+
+// resumes reports whether an instruction with the given op, which
+// ends its basic block, usually continues with the next instruction
+// once the operating system or debugger handling it returns.
+func resumes(op Op) bool {
+	switch op {
+	case SYSCALL, SYSENTER, INT, INTO, ICEBP:
+		return true
+	}
+	return false
+}
+
+// AppendCoverageMap appends a compact encoding of blocks and edges,
+// as returned by Blocks, to b and returns the result. The encoding,
+// which fuzzers instrumenting a binary can load to map coverage back
+// to code, is a sequence of unsigned varints:
+//
+//	number of blocks
+//	for each block: Start minus the previous block's End (or Start, for the first block), then End minus Start
+//	number of edges
+//	for each edge: the indexes in blocks of the From and To blocks
+//
+// Identifiers are not stored, since BlockID recomputes them.
+// Edges between blocks not in blocks are left out.
+func AppendCoverageMap(b []byte, blocks []Block, edges []Edge) []byte {
+	index := make(map[uint64]int, len(blocks))
+	b = appendUvarint(b, uint64(len(blocks)))
+	prev := uint64(0)
+	for i, blk := range blocks {
+		index[blk.Start] = i
+		b = appendUvarint(b, blk.Start-prev)
+		b = appendUvarint(b, blk.End-blk.Start)
+		prev = blk.End
+	}
+	n := 0
+	for _, e := range edges {
+		_, ok1 := index[e.From]
+		_, ok2 := index[e.To]
+		if ok1 && ok2 {
+			n++
+		}
+	}
+	b = appendUvarint(b, uint64(n))
+	for _, e := range edges {
+		from, ok1 := index[e.From]
+		to, ok2 := index[e.To]
+		if ok1 && ok2 {
+			b = appendUvarint(b, uint64(from))
+			b = appendUvarint(b, uint64(to))
+		}
+	}
+	return b
+}
+
+// appendUvarint is binary.AppendUvarint, which needs Go 1.19.
+func appendUvarint(b []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], x)]...)
+}
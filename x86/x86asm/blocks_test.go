@@ -0,0 +1,110 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestBlocks(t *testing.T) {
+	code := []byte{
+		0x85, 0xc0, // test eax, eax
+		0x74, 0x03, // je 0x1007
+		0x48, 0xff, 0xc0, // inc rax
+		0xc3,       // ret
+		0x06,       // invalid in 64-bit mode
+		0xeb, 0xf5, // jmp 0x1000
+	}
+	blocks, edges, err := Blocks(code, 0x1000, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantBlocks := []Block{
+		{0x1000, 0x1004, BlockID(0x1000)},
+		{0x1004, 0x1007, BlockID(0x1004)},
+		{0x1007, 0x1008, BlockID(0x1007)},
+		{0x1009, 0x100b, BlockID(0x1009)},
+	}
+	if !reflect.DeepEqual(blocks, wantBlocks) {
+		t.Errorf("blocks = %x, want %x", blocks, wantBlocks)
+	}
+	edge := func(from, to uint64) Edge {
+		return Edge{from, to, BlockID(from)>>1 ^ BlockID(to)}
+	}
+	wantEdges := []Edge{
+		edge(0x1000, 0x1004),
+		edge(0x1000, 0x1007),
+		edge(0x1004, 0x1007),
+		edge(0x1009, 0x1000),
+	}
+	if !reflect.DeepEqual(edges, wantEdges) {
+		t.Errorf("edges = %x, want %x", edges, wantEdges)
+	}
+	if e := edge(0x1000, 0x1004); e.ID == edge(0x1004, 0x1000).ID {
+		t.Errorf("edge and reverse edge have the same ID %#x", e.ID)
+	}
+
+	m := AppendCoverageMap(nil, blocks, edges)
+	want := []byte{
+		4, 0x80, 0x20, 4, 0, 3, 0, 1, 1, 2,
+		4, 0, 1, 0, 2, 1, 2, 3, 0,
+	}
+	if !bytes.Equal(m, want) {
+		t.Errorf("AppendCoverageMap = %x, want %x", m, want)
+	}
+}
+
+func TestBlocksEnd(t *testing.T) {
+	// Instructions after which execution does not continue end their
+	// block, even when the next instruction is not a jump target.
+	for _, enc := range [][]byte{
+		{0xf3, 0x0f, 0x01, 0xec}, // uiret
+		{0xf1},                   // icebp
+		{0x0f, 0xaa},             // rsm
+	} {
+		code := append(append([]byte{}, enc...), 0x90) // nop
+		blocks, _, err := Blocks(code, 0, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(blocks) != 2 {
+			t.Errorf("Blocks(% x) = %x, want two blocks", code, blocks)
+		}
+	}
+}
+
+func TestBlocksSyscall(t *testing.T) {
+	// Execution resumes after a system call or software interrupt,
+	// so the block after it is a successor, but not after UD2.
+	for _, tt := range []struct {
+		code  []byte
+		edges int
+	}{
+		{[]byte{0x0f, 0x05, 0x90, 0xc3}, 1}, // syscall; nop; ret
+		{[]byte{0xcd, 0x80, 0x90, 0xc3}, 1}, // int 0x80; nop; ret
+		{[]byte{0xcc, 0x90, 0xc3}, 1},       // int3; nop; ret
+		{[]byte{0x0f, 0x0b, 0x90, 0xc3}, 0}, // ud2; nop; ret
+	} {
+		blocks, edges, err := Blocks(tt.code, 0x1000, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(blocks) != 2 || len(edges) != tt.edges {
+			t.Errorf("Blocks(% x) = %x, %x, want 2 blocks and %d edges", tt.code, blocks, edges, tt.edges)
+			continue
+		}
+		if tt.edges > 0 && (edges[0].From != blocks[0].Start || edges[0].To != blocks[1].Start) {
+			t.Errorf("Blocks(% x) edge = %x, want %#x to %#x", tt.code, edges[0], blocks[0].Start, blocks[1].Start)
+		}
+	}
+}
+
+func TestBlocksMode(t *testing.T) {
+	if _, _, err := Blocks([]byte{0x90}, 0, 8); err != ErrInvalidMode {
+		t.Errorf("Blocks in mode 8: err = %v, want %v", err, ErrInvalidMode)
+	}
+}
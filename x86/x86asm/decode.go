@@ -224,10 +224,23 @@ var decoderCover []bool
 // The mode arguments specifies the assumed processor mode:
 // 16, 32, or 64 for 16-, 32-, and 64-bit execution modes.
 func Decode(src []byte, mode int) (inst Inst, err error) {
-	return decode1(src, mode, false)
+	return decode1(src, mode, false, nil)
 }
 
-// decode1 is the implementation of Decode but takes an extra
+// decode1 is the implementation of Decode: it decodes src using the
+// built-in tables and, if they do not recognize the instruction,
+// the registered extensions.
+func decode1(src []byte, mode int, gnuCompat bool, ac *argCache) (Inst, error) {
+	inst, err := decodeTables(src, mode, gnuCompat, ac)
+	if err == ErrUnrecognized || err == nil && inst.Op == 0 {
+		if ext, ok := decodeExtension(src, mode); ok {
+			return ext, nil
+		}
+	}
+	return inst, err
+}
+
+// decodeTables decodes src using only the built-in tables. It takes an extra
 // gnuCompat flag to cause it to change its behavior to mimic
 // bugs (or at least unique features) of GNU libopcodes as used
 // by objdump. We don't believe that logic is the right thing to do
@@ -235,7 +248,8 @@ func Decode(src []byte, mode int) (inst Inst, err error) {
 // comparison if we adjust a few small pieces of logic.
 // The affected logic is in the conditional branch for "mandatory" prefixes,
 // case xCondPrefix.
-func decode1(src []byte, mode int, gnuCompat bool) (Inst, error) {
+// If ac is not nil, decodeTables uses it to avoid allocating argument values.
+func decodeTables(src []byte, mode int, gnuCompat bool, ac *argCache) (Inst, error) {
 	switch mode {
 	case 16, 32, 64:
 		// ok
@@ -1028,27 +1042,27 @@ Decode:
 			narg++
 
 		case xArgImm8:
-			inst.Args[narg] = Imm(imm8)
+			inst.Args[narg] = ac.imm(Imm(imm8))
 			narg++
 
 		case xArgImm8u:
-			inst.Args[narg] = Imm(uint8(imm8))
+			inst.Args[narg] = ac.imm(Imm(uint8(imm8)))
 			narg++
 
 		case xArgImm16:
-			inst.Args[narg] = Imm(int16(imm))
+			inst.Args[narg] = ac.imm(Imm(int16(imm)))
 			narg++
 
 		case xArgImm16u:
-			inst.Args[narg] = Imm(uint16(imm))
+			inst.Args[narg] = ac.imm(Imm(uint16(imm)))
 			narg++
 
 		case xArgImm32:
-			inst.Args[narg] = Imm(int32(imm))
+			inst.Args[narg] = ac.imm(Imm(int32(imm)))
 			narg++
 
 		case xArgImm64:
-			inst.Args[narg] = Imm(imm)
+			inst.Args[narg] = ac.imm(Imm(imm))
 			narg++
 
 		case xArgM,
@@ -1082,7 +1096,7 @@ Decode:
 				inst.Op = 0
 				break Decode
 			}
-			inst.Args[narg] = mem
+			inst.Args[narg] = ac.mem(mem)
 			inst.MemBytes = int(memBytes[decodeOp(x)])
 			if mem.Base == RIP {
 				inst.PCRel = displen
@@ -1091,13 +1105,13 @@ Decode:
 			narg++
 
 		case xArgPtr16colon16:
-			inst.Args[narg] = Imm(immc >> 16)
-			inst.Args[narg+1] = Imm(immc & (1<<16 - 1))
+			inst.Args[narg] = ac.imm(Imm(immc >> 16))
+			inst.Args[narg+1] = ac.imm(Imm(immc & (1<<16 - 1)))
 			narg += 2
 
 		case xArgPtr16colon32:
-			inst.Args[narg] = Imm(immc >> 32)
-			inst.Args[narg+1] = Imm(immc & (1<<32 - 1))
+			inst.Args[narg] = ac.imm(Imm(immc >> 32))
+			inst.Args[narg+1] = ac.imm(Imm(immc & (1<<32 - 1)))
 			narg += 2
 
 		case xArgMoffs8, xArgMoffs16, xArgMoffs32, xArgMoffs64:
@@ -1107,7 +1121,7 @@ Decode:
 				mem.Segment = prefixToSegment(inst.Prefix[segIndex])
 				inst.Prefix[segIndex] |= PrefixImplicit
 			}
-			inst.Args[narg] = mem
+			inst.Args[narg] = ac.mem(mem)
 			inst.MemBytes = int(memBytes[decodeOp(x)])
 			if mem.Base == RIP {
 				inst.PCRel = displen
@@ -1190,7 +1204,7 @@ Decode:
 			xArgXmm2M16, xArgXmm2M32, xArgXmm2M64, xArgXmmM64, xArgXmmM128, xArgXmmM32, xArgXmm2M128,
 			xArgYmm2M256:
 			if haveMem {
-				inst.Args[narg] = mem
+				inst.Args[narg] = ac.mem(mem)
 				inst.MemBytes = int(memBytes[decodeOp(x)])
 				if mem.Base == RIP {
 					inst.PCRel = displen
@@ -1237,19 +1251,19 @@ Decode:
 		case xArgRel8:
 			inst.PCRelOff = immcpos
 			inst.PCRel = 1
-			inst.Args[narg] = Rel(int8(immc))
+			inst.Args[narg] = ac.rel(Rel(int8(immc)))
 			narg++
 
 		case xArgRel16:
 			inst.PCRelOff = immcpos
 			inst.PCRel = 2
-			inst.Args[narg] = Rel(int16(immc))
+			inst.Args[narg] = ac.rel(Rel(int16(immc)))
 			narg++
 
 		case xArgRel32:
 			inst.PCRelOff = immcpos
 			inst.PCRel = 4
-			inst.Args[narg] = Rel(int32(immc))
+			inst.Args[narg] = ac.rel(Rel(int32(immc)))
 			narg++
 		}
 	}
@@ -1316,23 +1330,23 @@ Decode:
 	usedAddrSize := false
 	switch inst.Op {
 	case INSB, INSW, INSD:
-		inst.Args[0] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
+		inst.Args[0] = ac.mem(Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX})
 		inst.Args[1] = DX
 		usedAddrSize = true
 
 	case OUTSB, OUTSW, OUTSD:
 		inst.Args[0] = DX
-		inst.Args[1] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
+		inst.Args[1] = ac.mem(Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX})
 		usedAddrSize = true
 
 	case MOVSB, MOVSW, MOVSD, MOVSQ:
-		inst.Args[0] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
-		inst.Args[1] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
+		inst.Args[0] = ac.mem(Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX})
+		inst.Args[1] = ac.mem(Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX})
 		usedAddrSize = true
 
 	case CMPSB, CMPSW, CMPSD, CMPSQ:
-		inst.Args[0] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
-		inst.Args[1] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
+		inst.Args[0] = ac.mem(Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX})
+		inst.Args[1] = ac.mem(Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX})
 		usedAddrSize = true
 
 	case LODSB, LODSW, LODSD, LODSQ:
@@ -1346,11 +1360,11 @@ Decode:
 		case LODSQ:
 			inst.Args[0] = RAX
 		}
-		inst.Args[1] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
+		inst.Args[1] = ac.mem(Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX})
 		usedAddrSize = true
 
 	case STOSB, STOSW, STOSD, STOSQ:
-		inst.Args[0] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
+		inst.Args[0] = ac.mem(Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX})
 		switch inst.Op {
 		case STOSB:
 			inst.Args[1] = AL
@@ -1364,7 +1378,7 @@ Decode:
 		usedAddrSize = true
 
 	case SCASB, SCASW, SCASD, SCASQ:
-		inst.Args[1] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
+		inst.Args[1] = ac.mem(Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX})
 		switch inst.Op {
 		case SCASB:
 			inst.Args[0] = AL
@@ -1378,7 +1392,7 @@ Decode:
 		usedAddrSize = true
 
 	case XLATB:
-		inst.Args[0] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + BX - AX}
+		inst.Args[0] = ac.mem(Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + BX - AX})
 		usedAddrSize = true
 	}
 
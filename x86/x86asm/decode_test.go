@@ -52,17 +52,25 @@ func TestDecode(t *testing.T) {
 		if err != nil {
 			out = "error: " + err.Error()
 		} else {
+			var toks []Token
+			emit := func(t Token) { toks = append(toks, t) }
 			switch syntax {
 			case "gnu":
 				out = GNUSyntax(inst, 0, nil)
+				GNUSyntaxTokens(inst, 0, nil, emit)
 			case "intel":
 				out = IntelSyntax(inst, 0, nil)
+				IntelSyntaxTokens(inst, 0, nil, emit)
 			case "plan9": // [sic]
 				out = GoSyntax(inst, 0, nil)
+				GoSyntaxTokens(inst, 0, nil, emit)
 			default:
 				t.Errorf("unknown syntax %q", syntax)
 				continue
 			}
+			if s := joinTokens(toks); s != out {
+				t.Errorf("Decode(%s) [%s] tokens = %q, want %q", f[0], syntax, s, out)
+			}
 		}
 		if out != asm || inst.Len != size {
 			t.Errorf("Decode(%s) [%s] = %s, %d, want %s, %d", f[0], syntax, out, inst.Len, asm, size)
@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "sync/atomic"
+
+// A Decoder decodes instructions for a single processor mode.
+// It remembers recently decoded argument values so that decoding
+// a long stream of instructions does not allocate a new copy of
+// each immediate, relative offset and memory reference it sees:
+// in steady state, Decode allocates only for argument values that
+// have not been seen recently. AppendGNUSyntax can then format the
+// instructions into a reused buffer, also without allocating.
+//
+// A Decoder is safe for concurrent use by multiple goroutines,
+// provided its fields are not changed while it is in use.
+type Decoder struct {
+	Mode int // processor mode: 16, 32, or 64
+
+	// Features, if non-nil, lists the features of the processor
+	// that will execute the code. Decode then returns ErrUnsupported
+	// for instructions that need other features, and decodes
+	// encodings that such a processor executes as some older
+	// instruction, such as LZCNT without LZCNT support, as that
+	// instruction, marking the F3 prefix that distinguishes them as
+	// PrefixIgnored. Instructions that x86.csv does not tag with
+	// a feature, which include the base integer and x87 instruction
+	// sets, are always accepted. See FeaturesFromCPUID.
+	Features *FeatureSet
+
+	args argCache
+}
+
+// Decode decodes the leading bytes in src as a single instruction,
+// storing the result in *inst. It is otherwise like the package-level
+// Decode function.
+func (d *Decoder) Decode(src []byte, inst *Inst) error {
+	var err error
+	*inst, err = decode1(src, d.Mode, false, &d.args)
+	if err == nil && d.Features != nil {
+		err = checkFeatures(inst, *d.Features)
+	}
+	return err
+}
+
+// argCacheBits is the log2 of the number of entries
+// for each kind of argument in an argCache.
+const argCacheBits = 8
+
+// An argCache holds the interface values of recently decoded arguments,
+// which would otherwise be allocated again on every use.
+// It is direct-mapped: each value has a single slot, and a new
+// value replaces whatever was in its slot. The slots are atomic,
+// so that goroutines sharing a Decoder can share its cache.
+// A nil *argCache is valid and caches nothing.
+type argCache struct {
+	imms [1 << argCacheBits]atomic.Value // Imm
+	rels [1 << argCacheBits]atomic.Value // Rel
+	mems [1 << argCacheBits]atomic.Value // Mem
+}
+
+func argHash(x uint64) uint64 {
+	return (x * 0x9e3779b97f4a7c15) >> (64 - argCacheBits)
+}
+
+func (c *argCache) imm(x Imm) Arg {
+	if c == nil {
+		return x
+	}
+	p := &c.imms[argHash(uint64(x))]
+	a, _ := p.Load().(Arg)
+	if a == nil || a.(Imm) != x {
+		a = x
+		p.Store(a)
+	}
+	return a
+}
+
+func (c *argCache) rel(x Rel) Arg {
+	if c == nil {
+		return x
+	}
+	p := &c.rels[argHash(uint64(x))]
+	a, _ := p.Load().(Arg)
+	if a == nil || a.(Rel) != x {
+		a = x
+		p.Store(a)
+	}
+	return a
+}
+
+func (c *argCache) mem(x Mem) Arg {
+	if c == nil {
+		return x
+	}
+	h := uint64(x.Disp) ^ uint64(x.Segment)<<56 ^ uint64(x.Base)<<48 ^ uint64(x.Index)<<40 ^ uint64(x.Scale)<<32
+	p := &c.mems[argHash(h)]
+	a, _ := p.Load().(Arg)
+	if a == nil || a.(Mem) != x {
+		a = x
+		p.Store(a)
+	}
+	return a
+}
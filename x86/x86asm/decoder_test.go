@@ -0,0 +1,133 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+var decoderTests = [][]byte{
+	{0x48, 0x8b, 0x43, 0x08},                         // mov rax, [rbx+8]
+	{0x48, 0xc7, 0xc0, 0x78, 0x56, 0x34, 0x12},       // mov rax, 0x12345678
+	{0xe8, 0x01, 0x02, 0x03, 0x04},                   // call
+	{0xf3, 0x48, 0xab},                               // rep stosq
+	{0x0f, 0x0b},                                     // ud2
+	{0x8b, 0x84, 0x8b, 0x78, 0x56, 0x34, 0x12},       // mov eax, [rbx+rcx*4+0x12345678]
+	{0x66, 0x0f, 0x6f, 0x80, 0x00, 0x01, 0x00, 0x00}, // movdqa xmm0, [rax+0x100]
+}
+
+func TestDecoder(t *testing.T) {
+	d := Decoder{Mode: 64}
+	var inst Inst
+	for _, enc := range decoderTests {
+		want, wantErr := Decode(enc, 64)
+		for i := 0; i < 2; i++ { // once to fill the cache, once to use it
+			err := d.Decode(enc, &inst)
+			if err != wantErr || !reflect.DeepEqual(inst, want) {
+				t.Errorf("Decoder.Decode(% x) = %v, %v, want %v, %v", enc, inst, err, want, wantErr)
+			}
+		}
+	}
+}
+
+func TestDecoderConcurrent(t *testing.T) {
+	// Goroutines sharing a Decoder replace each other's cache
+	// entries but must still decode like Decode.
+	r := rand.New(rand.NewSource(1))
+	encs := make([][]byte, 1000)
+	for i := range encs {
+		encs[i] = make([]byte, 15)
+		r.Read(encs[i])
+	}
+	d := Decoder{Mode: 64}
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			var inst Inst
+			for i := range encs {
+				enc := encs[(i+g*250)%len(encs)]
+				want, wantErr := Decode(enc, 64)
+				if err := d.Decode(enc, &inst); err != wantErr || !reflect.DeepEqual(inst, want) {
+					t.Errorf("Decoder.Decode(% x) = %v, %v, want %v, %v", enc, inst, err, want, wantErr)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestDecoderAllocs(t *testing.T) {
+	d := Decoder{Mode: 64}
+	var inst Inst
+	for _, enc := range decoderTests {
+		d.Decode(enc, &inst)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, enc := range decoderTests {
+			d.Decode(enc, &inst)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Decoder.Decode allocates %v times per run, want 0", allocs)
+	}
+}
+
+func TestDecoderSyntaxAllocs(t *testing.T) {
+	d := Decoder{Mode: 64}
+	var inst Inst
+	var buf []byte
+	for _, enc := range decoderTests {
+		d.Decode(enc, &inst)
+		buf = AppendGNUSyntax(buf[:0], inst, 0x1000, nil)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, enc := range decoderTests {
+			d.Decode(enc, &inst)
+			buf = AppendGNUSyntax(buf[:0], inst, 0x1000, nil)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Decoder.Decode and AppendGNUSyntax allocate %v times per run, want 0", allocs)
+	}
+}
+
+func TestAppendGNUSyntax(t *testing.T) {
+	buf := []byte("prefix: ")
+	for _, enc := range decoderTests {
+		inst, err := Decode(enc, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := GNUSyntax(inst, 0x1000, nil)
+		if got := AppendGNUSyntax(buf, inst, 0x1000, nil); string(got) != "prefix: "+want {
+			t.Errorf("AppendGNUSyntax(% x) = %q, want %q", enc, got, "prefix: "+want)
+		}
+	}
+}
+
+func BenchmarkDecoder(b *testing.B) {
+	d := Decoder{Mode: 64}
+	var inst Inst
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, enc := range decoderTests {
+			d.Decode(enc, &inst)
+		}
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, enc := range decoderTests {
+			Decode(enc, 64)
+		}
+	}
+}
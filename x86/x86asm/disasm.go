@@ -0,0 +1,52 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"io"
+)
+
+// Disassemble writes a GNU syntax listing of the code at addresses
+// [start, end) to w, one instruction per line, showing the address,
+// the encoding and the instruction, as objdump does:
+//
+//	1000:	48 01 d8	add %rbx,%rax
+//
+// text holds code located at address base, such as an *elf.Section
+// with base set to the section's Addr. Bytes that do not decode are
+// shown as .byte directives; see GNUSyntaxFallback.
+func Disassemble(w io.Writer, text io.ReaderAt, base, start, end uint64, mode int) error {
+	code, err := readCode(text, base, start, end)
+	if err != nil {
+		return err
+	}
+	for pc := start; len(code) > 0; {
+		asm, n := GNUSyntaxFallback(code, mode, pc, nil)
+		if n == 0 {
+			return ErrInvalidMode
+		}
+		if _, err := fmt.Fprintf(w, "%x:\t% x\t%s\n", pc, code[:n], asm); err != nil {
+			return err
+		}
+		code = code[n:]
+		pc += uint64(n)
+	}
+	return nil
+}
+
+// readCode reads the bytes at addresses [start, end)
+// from text, which holds code located at base.
+func readCode(text io.ReaderAt, base, start, end uint64) ([]byte, error) {
+	if start < base || end < start {
+		return nil, fmt.Errorf("x86asm: invalid address range %#x-%#x for text at %#x", start, end, base)
+	}
+	code := make([]byte, end-start)
+	n, err := text.ReadAt(code, int64(start-base))
+	if n == len(code) {
+		err = nil
+	}
+	return code, err
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+
+	"golang.org/x/arch/x86/x86asm"
+)
+
+func ExampleDecode() {
+	code := []byte{0x48, 0x8d, 0x04, 0x1b} // lea (%rbx,%rbx,1),%rax
+	inst, err := x86asm.Decode(code, 64)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(inst.Op, inst.Len)
+	fmt.Println(x86asm.GNUSyntax(inst, 0x1000, nil))
+	fmt.Println(x86asm.IntelSyntax(inst, 0x1000, nil))
+	fmt.Println(x86asm.GoSyntax(inst, 0x1000, nil))
+	// Output:
+	// LEA 4
+	// lea (%rbx,%rbx,1),%rax
+	// lea rax, ptr [rbx+rbx*1]
+	// LEAQ 0(BX)(BX*1), AX
+}
+
+func ExampleDisassemble() {
+	// A function at address 0x1000 in a text section starting
+	// at 0x1000, as from an *elf.Section.
+	text := bytes.NewReader([]byte{
+		0x55,             // push %rbp
+		0x48, 0x89, 0xe5, // mov %rsp,%rbp
+		0x06, // invalid in 64-bit mode
+		0x5d, // pop %rbp
+		0xc3, // ret
+	})
+	if err := x86asm.Disassemble(os.Stdout, text, 0x1000, 0x1000, 0x1007, 64); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// 1000:	55	push %rbp
+	// 1001:	48 89 e5	mov %rsp,%rbp
+	// 1004:	06	.byte 0x06	# (bad)
+	// 1005:	5d	pop %rbp
+	// 1006:	c3	retq
+}
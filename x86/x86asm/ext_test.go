@@ -248,7 +248,7 @@ func disasm(syntax string, mode int, src []byte) (inst Inst, text string) {
 		cover -= coverage()
 	}
 
-	inst, err := decode1(src, mode, syntax == "gnu")
+	inst, err := decode1(src, mode, syntax == "gnu", nil)
 	if err != nil {
 		text = "error: " + err.Error()
 	} else {
@@ -0,0 +1,104 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// An Extension decodes instructions that the built-in tables do not
+// describe, such as emulator hypercalls or other vendor-defined uses
+// of reserved opcodes.
+//
+// Decode consults the extensions only for bytes that the built-in
+// tables do not recognize. An Extension is called with the leading
+// bytes of the unrecognized instruction, including any prefixes, and
+// the processor mode. If it recognizes an instruction, it returns the
+// decoded instruction and true; otherwise it returns false and decoding
+// continues with the next extension. The returned Inst
+// must have a Len between 1 and len(src), and its Op is usually one
+// allocated by RegisterOp. Decode sets the Inst's Mode.
+//
+// The syntax functions format extension instructions like any other:
+// the Op's name, lower-cased for GNU, Intel and MASM syntax, followed by the
+// arguments in the syntax's usual order. Decoder and Blocks, which
+// use Decode, see extension instructions too; Blocks treats them as
+// continuing with the next instruction. InstLen, Faults and Suggest
+// describe how the processor itself executes an encoding and do not
+// consult extensions: for an extension instruction, InstLen follows
+// the general encoding rules and Faults and Suggest return
+// ErrUnrecognized.
+type Extension func(src []byte, mode int) (inst Inst, ok bool)
+
+var (
+	extMu   sync.Mutex
+	extList atomic.Value // []*Extension, replaced on each change
+
+	extOpMu    sync.RWMutex
+	extOpNames []string // names of Ops allocated by RegisterOp, from maxOp+1
+)
+
+// RegisterExtension adds ext to the extensions consulted by Decode,
+// which consults them in the order they were registered, and returns
+// a function that removes it again. RegisterExtension is intended to
+// be called during initialization; the returned function lets tests
+// and other short-lived users undo the registration.
+func RegisterExtension(ext Extension) (unregister func()) {
+	p := &ext
+	extMu.Lock()
+	defer extMu.Unlock()
+	old, _ := extList.Load().([]*Extension)
+	list := make([]*Extension, len(old), len(old)+1)
+	copy(list, old)
+	extList.Store(append(list, p))
+	return func() {
+		extMu.Lock()
+		defer extMu.Unlock()
+		old, _ := extList.Load().([]*Extension)
+		list := make([]*Extension, 0, len(old))
+		for _, e := range old {
+			if e != p {
+				list = append(list, e)
+			}
+		}
+		extList.Store(list)
+	}
+}
+
+// RegisterOp allocates a new Op with the given name, for use by extensions.
+// The name is the Op's String form, used by all the syntax functions.
+func RegisterOp(name string) Op {
+	extOpMu.Lock()
+	defer extOpMu.Unlock()
+	extOpNames = append(extOpNames, name)
+	return maxOp + Op(len(extOpNames))
+}
+
+// extOpName returns the name of an Op allocated by RegisterOp,
+// or "" if op was not allocated that way.
+func extOpName(op Op) string {
+	extOpMu.RLock()
+	defer extOpMu.RUnlock()
+	if op <= maxOp || int(op-maxOp) > len(extOpNames) {
+		return ""
+	}
+	return extOpNames[op-maxOp-1]
+}
+
+// decodeExtension tries the registered extensions on src.
+func decodeExtension(src []byte, mode int) (Inst, bool) {
+	exts, _ := extList.Load().([]*Extension)
+	if len(src) > 15 {
+		src = src[:15]
+	}
+	for _, ext := range exts {
+		if inst, ok := (*ext)(src, mode); ok && 0 < inst.Len && inst.Len <= len(src) {
+			inst.Mode = mode
+			return inst, true
+		}
+	}
+	return Inst{}, false
+}
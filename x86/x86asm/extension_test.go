@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestExtension(t *testing.T) {
+	op := RegisterOp("HYPERCALL")
+	// 0F 04 is undefined; treat 0F 04 'M' 'V' ib as a hypercall
+	// taking an 8-bit call number and its argument in RAX.
+	// The extension also tries to claim NOP, which the built-in
+	// tables recognize, so it is never asked about it.
+	unregister := RegisterExtension(func(src []byte, mode int) (Inst, bool) {
+		if len(src) > 0 && src[0] == 0x90 {
+			return Inst{Op: op, Len: 1}, true
+		}
+		if !bytes.HasPrefix(src, []byte{0x0f, 0x04, 'M', 'V'}) || len(src) < 5 {
+			return Inst{}, false
+		}
+		return Inst{
+			Op:   op,
+			Args: Args{Imm(src[4]), RAX},
+			Len:  5,
+		}, true
+	})
+	defer unregister()
+
+	if op.String() != "HYPERCALL" {
+		t.Errorf("RegisterOp name = %q, want HYPERCALL", op.String())
+	}
+	src := []byte{0x0f, 0x04, 'M', 'V', 0x2a, 0x90}
+	inst, err := Decode(src, 64)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if inst.Op != op || inst.Len != 5 || inst.Mode != 64 {
+		t.Errorf("Decode = %#v", inst)
+	}
+	for _, tt := range []struct{ syntax, want string }{
+		{"gnu", "hypercall %rax,$0x2a"},
+		{"intel", "hypercall 0x2a, rax"},
+		{"plan9", "HYPERCALL AX, $0x2a"},
+	} {
+		var out string
+		switch tt.syntax {
+		case "gnu":
+			out = GNUSyntax(inst, 0, nil)
+		case "intel":
+			out = IntelSyntax(inst, 0, nil)
+		case "plan9":
+			out = GoSyntax(inst, 0, nil)
+		}
+		if out != tt.want {
+			t.Errorf("%s syntax = %q, want %q", tt.syntax, out, tt.want)
+		}
+	}
+
+	// Other uses of 0F 04 are still undefined.
+	if _, err := Decode([]byte{0x0f, 0x04, 0x11, 0x22}, 64); err != ErrUnrecognized {
+		t.Errorf("Decode(0f 04 11 22) error = %v, want %v", err, ErrUnrecognized)
+	}
+	if inst, err := Decode([]byte{0x90}, 64); err != nil || inst.Op != NOP {
+		t.Errorf("Decode(90) = %v, %v, want NOP", inst, err)
+	}
+
+	// The processor does not execute extension instructions.
+	if _, err := Faults(src, 64); err != ErrUnrecognized {
+		t.Errorf("Faults(% x) error = %v, want %v", src, err, ErrUnrecognized)
+	}
+	if _, err := Suggest(src, 64); err != ErrUnrecognized {
+		t.Errorf("Suggest(% x) error = %v, want %v", src, err, ErrUnrecognized)
+	}
+
+	unregister()
+	if _, err := Decode(src, 64); err != ErrUnrecognized {
+		t.Errorf("Decode(% x) after unregister: error = %v, want %v", src, err, ErrUnrecognized)
+	}
+}
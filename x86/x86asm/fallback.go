@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxBadBytes is the most undecodable bytes GNUSyntaxFallback
+// puts in one .byte directive, as objdump shows at most 7 bytes
+// of encoding per line.
+const maxBadBytes = 7
+
+// GNUSyntaxFallback disassembles the instruction at the start of src,
+// located at pc, in the given processor mode (16, 32, or 64), and
+// returns its GNU syntax and length. Unlike GNUSyntax, it always
+// produces output that an assembler accepts: if src does not start
+// with an instruction that Decode recognizes, GNUSyntaxFallback
+// returns a .byte directive for the undecodable bytes, up to the next
+// decodable one, with a comment marking them as objdump does:
+//
+//	.byte 0x0f,0xff	# (bad)
+//
+// Callers disassembling a region call GNUSyntaxFallback repeatedly,
+// advancing src and pc by the returned length each time.
+//
+// If pc is 0, GNUSyntaxFallback prints branch targets relative to
+// the start of the instruction, as the assembler reads ".", so that
+// "eb fe" is "jmp .+0x0". GNUSyntax instead prints them relative to
+// the end of the instruction, as objdump does.
+// It returns "", 0 only if src is empty or mode is invalid.
+func GNUSyntaxFallback(src []byte, mode int, pc uint64, symname SymLookup) (string, int) {
+	if len(src) == 0 {
+		return "", 0
+	}
+	if inst, ok := decodeOK(src, mode); ok {
+		if pc == 0 {
+			for i, a := range inst.Args {
+				if rel, ok := a.(Rel); ok {
+					inst.Args[i] = rel + Rel(inst.Len)
+				}
+			}
+		}
+		return GNUSyntax(inst, pc, symname), inst.Len
+	}
+	if mode != 16 && mode != 32 && mode != 64 {
+		return "", 0
+	}
+	n := 1
+	for n < len(src) && n < maxBadBytes {
+		if _, ok := decodeOK(src[n:], mode); ok {
+			break
+		}
+		n++
+	}
+	var b strings.Builder
+	b.WriteString(".byte ")
+	for i, c := range src[:n] {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, "%#02x", c)
+	}
+	b.WriteString("\t# (bad)")
+	return b.String(), n
+}
+
+// decodeOK decodes the instruction at the start of src and reports
+// whether it is one that Decode recognizes.
+func decodeOK(src []byte, mode int) (Inst, bool) {
+	inst, err := Decode(src, mode)
+	return inst, err == nil && inst.Op != 0
+}
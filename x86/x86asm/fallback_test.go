@@ -0,0 +1,66 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGNUSyntaxFallback(t *testing.T) {
+	src := []byte{
+		0x48, 0x01, 0xd8, // add %rbx,%rax
+		0x06, 0x27, // push %es and daa: invalid in 64-bit mode
+		0x0f, 0x0b, // ud2
+		0x48, 0x8b, // truncated mov
+	}
+	want := []string{
+		"0x1000 add %rbx,%rax",
+		"0x1003 .byte 0x06,0x27\t# (bad)",
+		"0x1005 ud2",
+		"0x1007 .byte 0x48,0x8b\t# (bad)",
+	}
+	var got []string
+	for pc := uint64(0x1000); len(src) > 0; {
+		text, n := GNUSyntaxFallback(src, 64, pc, nil)
+		if n <= 0 {
+			t.Fatalf("GNUSyntaxFallback(% x) = %q, %d", src, text, n)
+		}
+		got = append(got, fmt.Sprintf("%#x %s", pc, text))
+		src = src[n:]
+		pc += uint64(n)
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("have:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// A long undecodable run is split into lines of maxBadBytes.
+	bad := []byte{0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06}
+	if _, n := GNUSyntaxFallback(bad, 64, 0, nil); n != maxBadBytes {
+		t.Errorf("GNUSyntaxFallback(% x) consumed %d bytes, want %d", bad, n, maxBadBytes)
+	}
+	// With no pc, branch targets are relative to the start of the
+	// instruction, which is what "." means to the assembler.
+	rel := []struct {
+		src  []byte
+		want string
+	}{
+		{[]byte{0xeb, 0xfe}, "jmp .+0x0"},
+		{[]byte{0x74, 0x10}, "je .+0x12"},
+		{[]byte{0xe8, 0xf0, 0xff, 0xff, 0xff}, "callq .-0xb"},
+	}
+	for _, tt := range rel {
+		if text, _ := GNUSyntaxFallback(tt.src, 64, 0, nil); text != tt.want {
+			t.Errorf("GNUSyntaxFallback(% x, pc=0) = %q, want %q", tt.src, text, tt.want)
+		}
+	}
+	if text, _ := GNUSyntaxFallback([]byte{0xeb, 0xfe}, 64, 0x1000, nil); text != "jmp 0x1000" {
+		t.Errorf("GNUSyntaxFallback(eb fe, pc=0x1000) = %q, want %q", text, "jmp 0x1000")
+	}
+	if text, n := GNUSyntaxFallback(nil, 64, 0, nil); text != "" || n != 0 {
+		t.Errorf("GNUSyntaxFallback(nil) = %q, %d, want \"\", 0", text, n)
+	}
+}
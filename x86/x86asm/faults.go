@@ -0,0 +1,243 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "fmt"
+
+// An Exception is a processor exception that an instruction can raise.
+type Exception uint8
+
+const (
+	_           Exception = iota
+	ExceptionUD           // #UD, invalid opcode
+	ExceptionGP           // #GP, general protection
+)
+
+func (e Exception) String() string {
+	switch e {
+	case ExceptionUD:
+		return "#UD"
+	case ExceptionGP:
+		return "#GP"
+	}
+	return fmt.Sprintf("Exception(%d)", int(e))
+}
+
+// A Fault describes a way in which executing an instruction
+// raises, or can raise, an exception.
+type Fault struct {
+	Exception Exception
+
+	// Always reports whether the instruction raises the exception
+	// whenever it executes. If Always is false, the exception depends
+	// on the state of the processor, such as the privilege level,
+	// or on the values of the operands, such as a memory address.
+	Always bool
+
+	Reason string
+}
+
+func (f Fault) String() string {
+	s := f.Exception.String()
+	if !f.Always {
+		s += "?"
+	}
+	return s + " " + f.Reason
+}
+
+// Faults reports the ways in which the instruction at the start of
+// src raises or can raise #UD or #GP in the given processor mode
+// (16, 32, or 64). It returns no faults for an instruction that
+// executes normally in any state in which the processor supports it.
+//
+// Faults recognizes:
+//
+//   - instructions that do not exist in 64-bit mode
+//   - instructions longer than 15 bytes
+//   - LOCK prefixes on instructions that cannot be locked
+//   - prefixes not allowed before a VEX prefix
+//   - moves to CS, and the UD0, UD1 and UD2 instructions
+//   - privileged instructions, which fault outside ring 0
+//   - I/O and interrupt-flag instructions, which fault depending on IOPL
+//   - RDPMC, RDTSC and RDTSCP, which fault outside ring 0
+//     depending on CR4
+//   - memory operands that must be aligned, which fault when they are not
+//
+// It does not consider whether the processor supports the
+// instruction; see Decoder.Features for that. Faults returns
+// an error if src does not start with an instruction that Decode
+// recognizes and that is not explained by the checks above.
+// Instructions that only an Extension recognizes are not ones the
+// processor executes, so Faults returns ErrUnrecognized for them.
+func Faults(src []byte, mode int) ([]Fault, error) {
+	if mode != 16 && mode != 32 && mode != 64 {
+		return nil, ErrInvalidMode
+	}
+	if len(src) > 15 {
+		if _, err := InstLen(src, mode); err == ErrTruncated {
+			return []Fault{{ExceptionGP, true, "instruction longer than 15 bytes"}}, nil
+		}
+	}
+	if mode == 64 {
+		if f, ok := vexPrefixFault(src); ok {
+			return []Fault{f}, nil
+		}
+	}
+
+	inst, err := decodeTables(src, mode, false, nil)
+	if err == nil && inst.Op == 0 {
+		err = ErrUnrecognized
+	}
+	if err != nil {
+		// An instruction that decodes only in 32-bit mode, such as
+		// INC (40-47) or PUSH ES, does not exist in 64-bit mode.
+		// A truncated instruction, or one starting with a REX prefix,
+		// which 32-bit mode reads as INC or DEC, is merely undecodable,
+		// as is one starting with a VEX or EVEX prefix, which 32-bit
+		// mode can read as LES, LDS or BOUND.
+		if b, ok := firstNonLegacy(src); mode == 64 && err != ErrTruncated && ok &&
+			b&0xF0 != 0x40 && b != 0x62 && b != 0xC4 && b != 0xC5 {
+			if inst32, err32 := decodeTables(src, 32, false, nil); err32 == nil && inst32.Op != 0 {
+				return []Fault{{ExceptionUD, true, inst32.Op.String() + " is invalid in 64-bit mode"}}, nil
+			}
+		}
+		return nil, err
+	}
+
+	var faults []Fault
+	add := func(e Exception, always bool, format string, args ...interface{}) {
+		faults = append(faults, Fault{e, always, fmt.Sprintf(format, args...)})
+	}
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p&PrefixInvalid != 0 {
+			add(ExceptionUD, true, "%v prefix not allowed with %v", p, inst.Op)
+		}
+	}
+	switch inst.Op {
+	case UD0, UD1, UD2:
+		add(ExceptionUD, true, "%v is defined to be invalid", inst.Op)
+	case MOV:
+		if inst.Args[0] == CS {
+			add(ExceptionUD, true, "MOV to CS")
+		}
+	}
+	if privilegedOps[inst.Op] || isControlOrDebug(inst.Args[0]) || isControlOrDebug(inst.Args[1]) {
+		add(ExceptionGP, false, "%v is privileged: faults outside ring 0", inst.Op)
+	}
+	if cond, ok := ioplOps[inst.Op]; ok {
+		add(ExceptionGP, false, "%v faults when %s", inst.Op, cond)
+	}
+	if cond, ok := cr4Ops[inst.Op]; ok {
+		add(ExceptionGP, false, "%v faults when %s", inst.Op, cond)
+	}
+	if n := alignment(&inst); n > 1 {
+		add(ExceptionGP, false, "%v faults unless its memory operand is %d-byte aligned", inst.Op, n)
+	}
+	return faults, nil
+}
+
+// firstNonLegacy returns the first byte of src that is not a legacy
+// prefix: a REX, VEX or EVEX prefix or the opcode.
+func firstNonLegacy(src []byte) (byte, bool) {
+	for _, b := range src {
+		switch b {
+		case 0x26, 0x2E, 0x36, 0x3E, 0x64, 0x65, 0x66, 0x67, 0xF0, 0xF2, 0xF3:
+			continue
+		}
+		return b, true
+	}
+	return 0, false
+}
+
+// vexPrefixFault reports whether src starts with a VEX prefix
+// preceded by an operand-size, LOCK, REP or REX prefix,
+// which makes the instruction invalid.
+func vexPrefixFault(src []byte) (Fault, bool) {
+	i := 0
+	for i < len(src) && (src[i] == 0x66 || src[i] == 0xF0 || src[i] == 0xF2 || src[i] == 0xF3 || src[i]&0xF0 == 0x40) {
+		i++
+	}
+	if i == 0 || i == len(src) || src[i] != 0xC4 && src[i] != 0xC5 {
+		return Fault{}, false
+	}
+	return Fault{ExceptionUD, true, fmt.Sprintf("VEX prefix preceded by prefix %#02x", src[i-1])}, true
+}
+
+func isControlOrDebug(a Arg) bool {
+	r, ok := a.(Reg)
+	return ok && (CR0 <= r && r <= CR15 || DR0 <= r && r <= DR15)
+}
+
+// ioplOps lists the instructions that raise #GP
+// in protected mode depending on the I/O privilege level.
+var ioplOps = map[Op]string{
+	CLI:   "CPL > IOPL",
+	STI:   "CPL > IOPL",
+	IN:    "CPL > IOPL and the I/O permission bitmap denies the port",
+	INSB:  "CPL > IOPL and the I/O permission bitmap denies the port",
+	INSW:  "CPL > IOPL and the I/O permission bitmap denies the port",
+	INSD:  "CPL > IOPL and the I/O permission bitmap denies the port",
+	OUT:   "CPL > IOPL and the I/O permission bitmap denies the port",
+	OUTSB: "CPL > IOPL and the I/O permission bitmap denies the port",
+	OUTSW: "CPL > IOPL and the I/O permission bitmap denies the port",
+	OUTSD: "CPL > IOPL and the I/O permission bitmap denies the port",
+}
+
+// cr4Ops lists the instructions that raise #GP
+// outside ring 0 depending on CR4.
+var cr4Ops = map[Op]string{
+	RDPMC:  "CPL > 0 and CR4.PCE is clear",
+	RDTSC:  "CPL > 0 and CR4.TSD is set",
+	RDTSCP: "CPL > 0 and CR4.TSD is set",
+}
+
+// unalignedSSE lists the legacy SSE instructions with 16-byte
+// memory operands that need not be aligned.
+var unalignedSSE = map[Op]bool{
+	LDDQU:     true,
+	MOVDQU:    true,
+	MOVUPD:    true,
+	MOVUPS:    true,
+	PCMPESTRI: true,
+	PCMPESTRM: true,
+	PCMPISTRI: true,
+	PCMPISTRM: true,
+}
+
+// alignment returns the alignment that the memory operand
+// of inst must have, or 0 if it has no such requirement.
+func alignment(inst *Inst) int {
+	var mem, xmm bool
+	for _, a := range inst.Args {
+		switch a := a.(type) {
+		case Mem:
+			mem = true
+		case Reg:
+			if X0 <= a && a <= X15 {
+				xmm = true
+			}
+		}
+	}
+	if !mem {
+		return 0
+	}
+	switch inst.Op {
+	case CMPXCHG16B, FXSAVE, FXSAVE64, FXRSTOR, FXRSTOR64:
+		return 16
+	case XSAVE, XSAVE64, XSAVEC, XSAVEC64, XSAVEOPT, XSAVEOPT64, XSAVES, XSAVES64,
+		XRSTOR, XRSTOR64, XRSTORS, XRSTORS64:
+		return 64
+	case VMOVDQA, VMOVNTDQ, VMOVNTDQA:
+		return inst.MemBytes
+	}
+	vex := inst.Prefix[0]&0xFF == 0xC4 || inst.Prefix[0]&0xFF == 0xC5
+	if xmm && !vex && inst.MemBytes == 16 && !unalignedSSE[inst.Op] {
+		return 16
+	}
+	return 0
+}
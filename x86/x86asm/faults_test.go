@@ -0,0 +1,86 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+var faultsTests = []struct {
+	mode int
+	hex  string
+	want string // faults, separated by "; "
+}{
+	{64, "4889c8", ""}, // mov %rcx,%rax
+	{64, "06", "#UD PUSH is invalid in 64-bit mode"}, // push %es
+	{32, "06", ""}, // push %es
+	{64, "37", "#UD AAA is invalid in 64-bit mode"}, // aaa
+	{64, "f00101", ""}, // lock add %eax,(%rcx)
+	{64, "f001c8", "#UD LOCK prefix not allowed with ADD"}, // lock add %ecx,%eax
+	{64, "f090", "#UD LOCK prefix not allowed with NOP"},
+	{64, "0f0b", "#UD UD2 is defined to be invalid"},
+	{32, "8ec8", "#UD MOV to CS"},
+	{64, "66c5fd6f06", "#UD VEX prefix preceded by prefix 0x66"},
+	{64, "f4", "#GP? HLT is privileged: faults outside ring 0"},
+	{64, "0f22d8", "#GP? MOV is privileged: faults outside ring 0"}, // mov %rax,%cr3
+	{64, "fa", "#GP? CLI faults when CPL > IOPL"},
+	{64, "0f31", "#GP? RDTSC faults when CPL > 0 and CR4.TSD is set"},
+	{64, "0f01f9", "#GP? RDTSCP faults when CPL > 0 and CR4.TSD is set"},
+	{64, "0f33", "#GP? RDPMC faults when CPL > 0 and CR4.PCE is clear"},
+	{64, "0f2801", "#GP? MOVAPS faults unless its memory operand is 16-byte aligned"},
+	{64, "0f1001", ""},   // movups (%rcx),%xmm0
+	{64, "0f58c1", ""},   // addps %xmm1,%xmm0
+	{64, "f30f5801", ""}, // addss (%rcx),%xmm0
+	{64, "0f5801", "#GP? ADDPS faults unless its memory operand is 16-byte aligned"},
+	{64, "c5fe6f06", ""}, // vmovdqu (%rsi),%ymm0
+	{64, "c5fd6f06", "#GP? VMOVDQA faults unless its memory operand is 32-byte aligned"},
+	{64, "480fc70e", "#GP? CMPXCHG16B faults unless its memory operand is 16-byte aligned"},
+	{64, "0fae21", "#GP? XSAVE faults unless its memory operand is 64-byte aligned"},
+	{64, "6666666666666666666666666666669090", "#GP instruction longer than 15 bytes"},
+}
+
+func TestFaults(t *testing.T) {
+	for _, tt := range faultsTests {
+		src, err := hex.DecodeString(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		faults, err := Faults(src, tt.mode)
+		if err != nil {
+			t.Errorf("Faults(%s, %d): %v", tt.hex, tt.mode, err)
+			continue
+		}
+		var s []string
+		for _, f := range faults {
+			s = append(s, f.String())
+		}
+		if got := strings.Join(s, "; "); got != tt.want {
+			t.Errorf("Faults(%s, %d) = %q, want %q", tt.hex, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestFaultsErrors(t *testing.T) {
+	if _, err := Faults([]byte{0x90}, 8); err != ErrInvalidMode {
+		t.Errorf("Faults in mode 8: err = %v, want ErrInvalidMode", err)
+	}
+	for _, src := range []string{"\x8b", "\x48\x8b", "\x41", "\xf3\x48"} {
+		if f, err := Faults([]byte(src), 64); err == nil {
+			t.Errorf("Faults(% x) = %v, want error for truncated instruction", src, f)
+		}
+	}
+	// VEX and EVEX forms that the tables do not know are unrecognized,
+	// not LES, LDS or BOUND invalid in 64-bit mode.
+	for _, src := range []string{
+		"\x62\x01\xfc\x48\x10\x00", // EVEX vmovupd zmm8, [r8]
+		"\xc4\xe3\x79\x7f\xc0",     // VEX map 3, opcode 7F
+	} {
+		if f, err := Faults([]byte(src), 64); err != ErrUnrecognized {
+			t.Errorf("Faults(% x) = %v, %v, want %v", src, f, err, ErrUnrecognized)
+		}
+	}
+}
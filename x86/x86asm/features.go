@@ -0,0 +1,312 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupported is returned by a Decoder configured with Features
+// for an instruction that needs a feature the processor lacks.
+// Such an instruction raises an invalid-opcode exception (#UD).
+// The Decoder still returns the decoded instruction along with the error.
+var ErrUnsupported = errors.New("instruction not supported by processor")
+
+// A Feature is a processor feature, reported by the CPUID instruction,
+// that some instructions need.
+type Feature uint8
+
+const (
+	_ Feature = iota
+	FeatureMMX
+	FeatureSSE
+	FeatureSSE2
+	FeatureSSE3
+	FeatureSSSE3
+	FeatureSSE4_1
+	FeatureSSE4_2
+	FeatureAES
+	FeatureCLMUL // PCLMULQDQ
+	FeatureAVX
+	FeatureAVX2
+	FeatureFMA
+	FeatureF16C
+	FeatureBMI1
+	FeatureBMI2
+	FeatureLZCNT // LZCNT, also known as ABM
+	FeatureFSGSBASE
+	FeatureINVPCID
+	FeaturePRFCHW
+	FeatureRDRAND
+	FeatureHLE
+	FeatureRTM
+	FeatureXSAVEOPT
+	FeatureCET_IBT
+	FeatureCET_SS
+	FeatureUINTR
+	FeatureCMOV // CMOVcc and FCMOVcc
+	FeatureCX8  // CMPXCHG8B
+	FeatureCX16 // CMPXCHG16B
+	FeatureFXSR // FXSAVE and FXRSTOR
+	FeatureCLFSH
+	FeaturePOPCNT
+	FeatureMOVBE
+	FeatureXSAVE
+	FeatureXSAVEC
+	FeatureXSAVES
+	FeatureRDTSCP
+	FeatureMONITOR // MONITOR and MWAIT
+	FeatureSEP     // SYSENTER and SYSEXIT
+	maxFeature
+)
+
+var featureNames = [...]string{
+	FeatureMMX:      "MMX",
+	FeatureSSE:      "SSE",
+	FeatureSSE2:     "SSE2",
+	FeatureSSE3:     "SSE3",
+	FeatureSSSE3:    "SSSE3",
+	FeatureSSE4_1:   "SSE4_1",
+	FeatureSSE4_2:   "SSE4_2",
+	FeatureAES:      "AES",
+	FeatureCLMUL:    "CLMUL",
+	FeatureAVX:      "AVX",
+	FeatureAVX2:     "AVX2",
+	FeatureFMA:      "FMA",
+	FeatureF16C:     "F16C",
+	FeatureBMI1:     "BMI1",
+	FeatureBMI2:     "BMI2",
+	FeatureLZCNT:    "LZCNT",
+	FeatureFSGSBASE: "FSGSBASE",
+	FeatureINVPCID:  "INVPCID",
+	FeaturePRFCHW:   "PRFCHW",
+	FeatureRDRAND:   "RDRAND",
+	FeatureHLE:      "HLE",
+	FeatureRTM:      "RTM",
+	FeatureXSAVEOPT: "XSAVEOPT",
+	FeatureCET_IBT:  "CET_IBT",
+	FeatureCET_SS:   "CET_SS",
+	FeatureUINTR:    "UINTR",
+	FeatureCMOV:     "CMOV",
+	FeatureCX8:      "CX8",
+	FeatureCX16:     "CX16",
+	FeatureFXSR:     "FXSR",
+	FeatureCLFSH:    "CLFSH",
+	FeaturePOPCNT:   "POPCNT",
+	FeatureMOVBE:    "MOVBE",
+	FeatureXSAVE:    "XSAVE",
+	FeatureXSAVEC:   "XSAVEC",
+	FeatureXSAVES:   "XSAVES",
+	FeatureRDTSCP:   "RDTSCP",
+	FeatureMONITOR:  "MONITOR",
+	FeatureSEP:      "SEP",
+}
+
+func (f Feature) String() string {
+	if 0 < f && f < maxFeature {
+		return featureNames[f]
+	}
+	return fmt.Sprintf("Feature(%d)", int(f))
+}
+
+// A FeatureSet is a set of Features.
+type FeatureSet uint64
+
+// Has reports whether s contains f.
+func (s FeatureSet) Has(f Feature) bool {
+	return s&(1<<f) != 0
+}
+
+// Add adds f to s.
+func (s *FeatureSet) Add(f Feature) {
+	*s |= 1 << f
+}
+
+func (s FeatureSet) String() string {
+	var names []string
+	for f := Feature(1); f < maxFeature; f++ {
+		if s.Has(f) {
+			names = append(names, f.String())
+		}
+	}
+	return "{" + strings.Join(names, ",") + "}"
+}
+
+// A CPUIDLeaf holds the result of executing CPUID
+// with EAX set to Leaf and ECX set to Subleaf.
+type CPUIDLeaf struct {
+	Leaf, Subleaf      uint32
+	EAX, EBX, ECX, EDX uint32
+}
+
+// cpuidBits records where CPUID reports each feature.
+var cpuidBits = []struct {
+	leaf, subleaf uint32
+	reg           byte // 'a', 'b', 'c' or 'd'
+	bit           uint
+	f             Feature
+}{
+	{0x1, 0, 'd', 8, FeatureCX8},
+	{0x1, 0, 'd', 11, FeatureSEP},
+	{0x1, 0, 'd', 15, FeatureCMOV},
+	{0x1, 0, 'd', 19, FeatureCLFSH},
+	{0x1, 0, 'd', 23, FeatureMMX},
+	{0x1, 0, 'd', 24, FeatureFXSR},
+	{0x1, 0, 'd', 25, FeatureSSE},
+	{0x1, 0, 'd', 26, FeatureSSE2},
+	{0x1, 0, 'c', 0, FeatureSSE3},
+	{0x1, 0, 'c', 1, FeatureCLMUL},
+	{0x1, 0, 'c', 3, FeatureMONITOR},
+	{0x1, 0, 'c', 9, FeatureSSSE3},
+	{0x1, 0, 'c', 12, FeatureFMA},
+	{0x1, 0, 'c', 13, FeatureCX16},
+	{0x1, 0, 'c', 19, FeatureSSE4_1},
+	{0x1, 0, 'c', 20, FeatureSSE4_2},
+	{0x1, 0, 'c', 22, FeatureMOVBE},
+	{0x1, 0, 'c', 23, FeaturePOPCNT},
+	{0x1, 0, 'c', 25, FeatureAES},
+	{0x1, 0, 'c', 26, FeatureXSAVE},
+	{0x1, 0, 'c', 28, FeatureAVX},
+	{0x1, 0, 'c', 29, FeatureF16C},
+	{0x1, 0, 'c', 30, FeatureRDRAND},
+	{0x7, 0, 'b', 0, FeatureFSGSBASE},
+	{0x7, 0, 'b', 3, FeatureBMI1},
+	{0x7, 0, 'b', 4, FeatureHLE},
+	{0x7, 0, 'b', 5, FeatureAVX2},
+	{0x7, 0, 'b', 8, FeatureBMI2},
+	{0x7, 0, 'b', 10, FeatureINVPCID},
+	{0x7, 0, 'b', 11, FeatureRTM},
+	{0x7, 0, 'c', 7, FeatureCET_SS},
+	{0x7, 0, 'd', 5, FeatureUINTR},
+	{0x7, 0, 'd', 20, FeatureCET_IBT},
+	{0xd, 1, 'a', 0, FeatureXSAVEOPT},
+	{0xd, 1, 'a', 1, FeatureXSAVEC},
+	{0xd, 1, 'a', 3, FeatureXSAVES},
+	{0x80000001, 0, 'c', 5, FeatureLZCNT},
+	{0x80000001, 0, 'c', 8, FeaturePRFCHW},
+	{0x80000001, 0, 'd', 27, FeatureRDTSCP},
+}
+
+// FeaturesFromCPUID returns the features reported by a dump of CPUID
+// leaves, such as one captured along with a crash report.
+// Leaves missing from the dump report no features.
+//
+// The result describes what the processor implements. It does not
+// account for features, such as AVX, that the processor supports but
+// the operating system has not enabled; remove them from the result
+// if needed.
+func FeaturesFromCPUID(leaves []CPUIDLeaf) FeatureSet {
+	var s FeatureSet
+	for _, l := range leaves {
+		for _, b := range cpuidBits {
+			if l.Leaf != b.leaf || l.Subleaf != b.subleaf {
+				continue
+			}
+			var r uint32
+			switch b.reg {
+			case 'a':
+				r = l.EAX
+			case 'b':
+				r = l.EBX
+			case 'c':
+				r = l.ECX
+			case 'd':
+				r = l.EDX
+			}
+			if r&(1<<b.bit) != 0 {
+				s.Add(b.f)
+			}
+		}
+	}
+	return s
+}
+
+// checkFeatures reports whether a processor with the given features
+// can execute inst. Instructions for which x86.csv names no feature,
+// including the base instruction set, are always accepted. If the
+// processor cannot execute inst but executes the encoding as some
+// other instruction, as processors without LZCNT execute LZCNT as
+// BSR, checkFeatures rewrites inst to be that instruction and marks
+// the F3 prefix that the older instruction ignores as PrefixIgnored.
+func checkFeatures(inst *Inst, have FeatureSet) error {
+	reqs, ok := opFeatures[inst.Op]
+	if !ok {
+		return nil
+	}
+
+	// Some instructions have MMX forms that need MMX or SSE
+	// and XMM forms that need SSE2 or later. For those, only the
+	// alternatives for the form at hand apply.
+	const mmxFeatures = 1<<FeatureMMX | 1<<FeatureSSE
+	var mmxAlts, xmmAlts bool
+	for _, req := range reqs {
+		if req&mmxFeatures != 0 {
+			mmxAlts = true
+		} else {
+			xmmAlts = true
+		}
+	}
+	mmxForms := mmxAlts && xmmAlts
+	xmm := false
+	for _, a := range inst.Args {
+		if r, ok := a.(Reg); ok && X0 <= r && r <= X15 {
+			xmm = true
+		}
+	}
+	for _, req := range reqs {
+		if mmxForms && (req&mmxFeatures != 0) == xmm {
+			continue
+		}
+		if have&req == req {
+			return nil
+		}
+	}
+
+	// Older processors execute these as other instructions:
+	// the prefixes or encodings were previously ignored or reserved.
+	switch inst.Op {
+	case LZCNT:
+		inst.Op = BSR
+	case TZCNT:
+		inst.Op = BSF
+	case RDSSPD, RDSSPQ:
+		// The destination register becomes the NOP's r/m operand.
+		inst.Op = NOP
+	case ENDBR32, ENDBR64:
+		// The ModR/M byte, FA or FB, names the NOP's r/m operand:
+		// EDX or EBX, resized by the operand size and REX.B.
+		inst.Op = NOP
+		r := EAX
+		switch inst.DataSize {
+		case 16:
+			r = AX
+		case 64:
+			r = RAX
+		}
+		r += Reg(inst.Opcode >> 8 & 7)
+		for i, p := range inst.Prefix {
+			switch {
+			case p&0xFF == PrefixDataSize:
+				inst.Prefix[i] |= PrefixImplicit
+			case p&0xF0 == PrefixREX:
+				if p&PrefixREXB != 0 {
+					r += 8
+				}
+				inst.Prefix[i] |= PrefixImplicit
+			}
+		}
+		inst.Args = Args{r}
+	default:
+		return ErrUnsupported
+	}
+	for i, p := range inst.Prefix {
+		if p&0xFF == PrefixREP {
+			inst.Prefix[i] = p&^PrefixImplicit | PrefixIgnored
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestFeaturesFromCPUID(t *testing.T) {
+	leaves := []CPUIDLeaf{
+		{Leaf: 1, EDX: 1<<23 | 1<<25 | 1<<26, ECX: 1<<0 | 1<<28},
+		{Leaf: 7, Subleaf: 0, EBX: 1<<3 | 1<<8},
+		{Leaf: 7, Subleaf: 1, EBX: 1 << 3}, // wrong subleaf: ignored
+		{Leaf: 0x80000001, ECX: 1 << 5},
+	}
+	got := FeaturesFromCPUID(leaves)
+	var want FeatureSet
+	for _, f := range []Feature{FeatureMMX, FeatureSSE, FeatureSSE2, FeatureSSE3, FeatureAVX, FeatureBMI1, FeatureBMI2, FeatureLZCNT} {
+		want.Add(f)
+	}
+	if got != want {
+		t.Errorf("FeaturesFromCPUID = %v, want %v", got, want)
+	}
+}
+
+func TestDecoderFeatures(t *testing.T) {
+	var sse2 FeatureSet // a baseline amd64 processor
+	sse2.Add(FeatureMMX)
+	sse2.Add(FeatureSSE)
+	sse2.Add(FeatureSSE2)
+	mmx := FeatureSet(1 << FeatureMMX)
+
+	tests := []struct {
+		features FeatureSet
+		hex      string
+		want     string // GNU syntax, or "" for ErrUnsupported
+	}{
+		{sse2, "f30f58c1", "addss %xmm1,%xmm0"},
+		{sse2, "660f58c1", "addpd %xmm1,%xmm0"},
+		{mmx, "0f58c1", ""},                     // addps needs SSE
+		{sse2, "660f3800c1", ""},                // pshufb needs SSSE3
+		{sse2, "c5fe6f06", ""},                  // vmovdqu needs AVX
+		{sse2, "f30fbdc1", "rep bsr %ecx,%eax"}, // lzcnt runs as bsr
+		{sse2, "f30fbcc1", "rep bsf %ecx,%eax"}, // tzcnt runs as bsf
+		{sse2, "f30f1efa", "rep nop %edx"},      // endbr64
+		{sse2, "f30f1efb", "rep nop %ebx"},      // endbr32
+		{sse2, "f3410f1efa", "rep nop %r10d"},   // rex.B endbr64
+		{sse2, "66f30f1efa", "rep nop %dx"},     // data16 endbr64
+		{sse2, "f30f1ec8", "rep nop %eax"},      // rdsspd %eax
+		{sse2, "f3480f1ec8", "rep nop %rax"},    // rdsspq %rax
+		{sse2, "f30fb8c1", ""},                  // popcnt needs POPCNT
+		{sse2, "0f38f006", ""},                  // movbe needs MOVBE
+		{sse2, "480fc70e", ""},                  // cmpxchg16b needs CX16
+		{sse2, "0f44c1", ""},                    // cmove needs CMOV
+		{sse2, "0fae26", ""},                    // xsave needs XSAVE
+		{sse2 | 1<<FeatureCMOV, "0f44c1", "cmove %ecx,%eax"},
+		{sse2 | 1<<FeaturePOPCNT, "f30fb8c1", "popcnt %ecx,%eax"},
+		{mmx, "0ffcc1", "paddb %mm1,%mm0"},
+		{mmx, "660ffcc1", ""}, // xmm form needs SSE2
+		{sse2, "660ffcc1", "paddb %xmm1,%xmm0"},
+		{mmx, "90", "nop"},
+	}
+	for _, tt := range tests {
+		src, err := hex.DecodeString(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := Decoder{Mode: 64, Features: &tt.features}
+		var inst Inst
+		err = d.Decode(src, &inst)
+		if tt.want == "" {
+			if err != ErrUnsupported {
+				t.Errorf("%s with %v: err = %v, want ErrUnsupported", tt.hex, tt.features, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s with %v: %v", tt.hex, tt.features, err)
+			continue
+		}
+		if got := GNUSyntax(inst, 0, nil); got != tt.want {
+			t.Errorf("%s with %v = %q, want %q", tt.hex, tt.features, got, tt.want)
+		}
+	}
+}
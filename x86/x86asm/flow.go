@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "fmt"
+
+// A FlowKind classifies how an instruction can change the program
+// counter. Blocks and the control-flow classifications of the listing
+// packages are all derived from it, so that they agree.
+type FlowKind uint8
+
+const (
+	FlowNext      FlowKind = iota // always continues with the next instruction
+	FlowCall                      // CALL, LCALL
+	FlowReturn                    // RET, LRET
+	FlowJump                      // JMP, LJMP
+	FlowCondJump                  // Jcc, JCXZ and its relatives, LOOPcc, XBEGIN
+	FlowIntReturn                 // IRET, UIRET, RSM, SYSEXIT, SYSRET: return from an interrupt or the system
+	FlowSyscall                   // SYSCALL, SYSENTER
+	FlowTrap                      // INT, INTO, ICEBP (INT1), UD0, UD1, UD2
+	FlowAbort                     // XABORT, XEND: can jump to the fallback of the enclosing XBEGIN
+	FlowHalt                      // HLT
+)
+
+var flowKindNames = [...]string{
+	FlowNext:      "Next",
+	FlowCall:      "Call",
+	FlowReturn:    "Return",
+	FlowJump:      "Jump",
+	FlowCondJump:  "CondJump",
+	FlowIntReturn: "IntReturn",
+	FlowSyscall:   "Syscall",
+	FlowTrap:      "Trap",
+	FlowAbort:     "Abort",
+	FlowHalt:      "Halt",
+}
+
+func (k FlowKind) String() string {
+	if int(k) < len(flowKindNames) {
+		return flowKindNames[k]
+	}
+	return fmt.Sprintf("FlowKind(%d)", int(k))
+}
+
+// FlowOf returns how an instruction with the given op can change
+// the program counter.
+func FlowOf(op Op) FlowKind {
+	switch op {
+	case CALL, LCALL:
+		return FlowCall
+	case RET, LRET:
+		return FlowReturn
+	case JMP, LJMP:
+		return FlowJump
+	case JA, JAE, JB, JBE, JCXZ, JE, JECXZ, JG, JGE, JL, JLE, JNE,
+		JNO, JNP, JNS, JO, JP, JRCXZ, JS, LOOP, LOOPE, LOOPNE, XBEGIN:
+		return FlowCondJump
+	case IRET, IRETD, IRETQ, UIRET, RSM, SYSEXIT, SYSRET:
+		return FlowIntReturn
+	case SYSCALL, SYSENTER:
+		return FlowSyscall
+	case INT, INTO, ICEBP, UD0, UD1, UD2:
+		return FlowTrap
+	case XABORT, XEND:
+		return FlowAbort
+	case HLT:
+		return FlowHalt
+	}
+	return FlowNext
+}
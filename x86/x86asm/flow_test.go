@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "testing"
+
+func TestFlowOf(t *testing.T) {
+	tests := []struct {
+		code string
+		flow FlowKind
+	}{
+		{"\x48\x01\xd8", FlowNext},          // add rax, rbx
+		{"\xe8\x00\x00\x00\x00", FlowCall},  // call .+5
+		{"\xc3", FlowReturn},                // ret
+		{"\xeb\xfe", FlowJump},              // jmp .
+		{"\x74\x00", FlowCondJump},          // je .+2
+		{"\xe2\xfe", FlowCondJump},          // loop .
+		{"\x48\xcf", FlowIntReturn},         // iretq
+		{"\xf3\x0f\x01\xec", FlowIntReturn}, // uiret
+		{"\x0f\x05", FlowSyscall},           // syscall
+		{"\xcc", FlowTrap},                  // int3
+		{"\xf1", FlowTrap},                  // icebp
+		{"\x0f\x0b", FlowTrap},              // ud2
+		{"\x0f\x01\xd5", FlowAbort},         // xend
+		{"\xf4", FlowHalt},                  // hlt
+	}
+	for _, tt := range tests {
+		inst, err := Decode([]byte(tt.code), 64)
+		if err != nil {
+			t.Fatalf("Decode(% x): %v", tt.code, err)
+		}
+		if flow := FlowOf(inst.Op); flow != tt.flow {
+			t.Errorf("FlowOf(%v) = %v, want %v", inst.Op, flow, tt.flow)
+		}
+	}
+}
@@ -5,13 +5,27 @@
 package x86asm
 
 import (
-	"fmt"
+	"strconv"
 	"strings"
 )
 
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This general form is often called “AT&T syntax” as a reference to AT&T System V Unix.
 func GNUSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	return string(AppendGNUSyntax(nil, inst, pc, symname))
+}
+
+// AppendGNUSyntax appends the GNU assembler syntax for the instruction,
+// as GNUSyntax returns it, to dst and returns the extended buffer.
+// Together with a Decoder, it lets a disassembler format a stream of
+// instructions into a reused buffer without allocating.
+func AppendGNUSyntax(dst []byte, inst Inst, pc uint64, symname SymLookup) []byte {
+	return appendGNUSyntax(dst, inst, pc, symname, nil)
+}
+
+// appendGNUSyntax implements AppendGNUSyntax and, if ts is not nil,
+// adds the tokens of the appended text to ts.
+func appendGNUSyntax(dst []byte, inst Inst, pc uint64, symname SymLookup, ts *tokens) []byte {
 	// Rewrite instruction to mimic GNU peculiarities.
 	// Note that inst has been passed by value and contains
 	// no pointers, so any changes we make here are local
@@ -167,7 +181,7 @@ func GNUSyntax(inst Inst, pc uint64, symname SymLookup) string {
 		unmarkImplicit(&inst, PrefixDataSize)
 	}
 
-	if isCondJmp[inst.Op] || isLoop[inst.Op] || inst.Op == JCXZ || inst.Op == JECXZ || inst.Op == JRCXZ {
+	if inst.Op <= maxOp && (isCondJmp[inst.Op] || isLoop[inst.Op]) || inst.Op == JCXZ || inst.Op == JECXZ || inst.Op == JRCXZ {
 		if countPrefix(&inst, PrefixCS) > 0 && countPrefix(&inst, PrefixDS) > 0 {
 			for i, p := range inst.Prefix {
 				switch p & 0xFFF {
@@ -218,9 +232,12 @@ func GNUSyntax(inst Inst, pc uint64, symname SymLookup) string {
 	}
 
 	// Determine opcode.
-	op := strings.ToLower(inst.Op.String())
+	var opBuf [32]byte
+	var op []byte
 	if alt := gnuOp[inst.Op]; alt != "" {
-		op = alt
+		op = append(opBuf[:0], alt...)
+	} else {
+		op = appendLower(opBuf[:0], inst.Op.String())
 	}
 
 	// Determine opcode suffix.
@@ -279,23 +296,24 @@ SuffixLoop:
 		switch inst.Op {
 		case CMPXCHG8B, FLDCW, FNSTCW, FNSTSW, LDMXCSR, LLDT, LMSW, LTR, PCLMULQDQ,
 			SETA, SETAE, SETB, SETBE, SETE, SETG, SETGE, SETL, SETLE, SETNE, SETNO, SETNP, SETNS, SETO, SETP, SETS,
-			SLDT, SMSW, STMXCSR, STR, VERR, VERW:
+			SLDT, SMSW, STMXCSR, STR, VERR, VERW, CLRSSBSY, RSTORSSP:
 			// For various reasons, libopcodes emits no suffix for these instructions.
 
 		case CRC32:
-			op += byteSizeSuffix(argBytes(&inst, inst.Args[1]))
+			op = append(op, byteSizeSuffix(argBytes(&inst, inst.Args[1]))...)
 
 		case LGDT, LIDT, SGDT, SIDT:
-			op += byteSizeSuffix(inst.DataSize / 8)
+			op = append(op, byteSizeSuffix(inst.DataSize/8)...)
 
 		case MOVZX, MOVSX:
 			// Integer size conversions get two suffixes.
-			op = op[:4] + byteSizeSuffix(argBytes(&inst, inst.Args[1])) + byteSizeSuffix(argBytes(&inst, inst.Args[0]))
+			op = append(op[:4], byteSizeSuffix(argBytes(&inst, inst.Args[1]))...)
+			op = append(op, byteSizeSuffix(argBytes(&inst, inst.Args[0]))...)
 
 		case LOOP, LOOPE, LOOPNE:
 			// Add w suffix to indicate use of CX register instead of ECX.
 			if inst.AddrSize == 16 {
-				op += "w"
+				op = append(op, 'w')
 			}
 
 		case CALL, ENTER, JMP, LCALL, LEAVE, LJMP, LRET, RET, SYSRET, XBEGIN:
@@ -306,24 +324,24 @@ SuffixLoop:
 			}
 			if inst.DataSize == 16 && inst.Mode != 16 {
 				markLastImplicit(&inst, PrefixDataSize)
-				op += "w"
+				op = append(op, 'w')
 			} else if inst.Mode == 64 {
-				op += "q"
+				op = append(op, 'q')
 			}
 
 		case FRSTOR, FNSAVE, FNSTENV, FLDENV:
 			// Add s suffix to indicate shortened FPU state (I guess).
 			if inst.DataSize == 16 {
-				op += "s"
+				op = append(op, 's')
 			}
 
 		case PUSH, POP:
 			if markLastImplicit(&inst, PrefixDataSize) {
-				op += byteSizeSuffix(inst.DataSize / 8)
+				op = append(op, byteSizeSuffix(inst.DataSize/8)...)
 			} else if inst.Mode == 64 {
-				op += "q"
+				op = append(op, 'q')
 			} else {
-				op += byteSizeSuffix(inst.MemBytes)
+				op = append(op, byteSizeSuffix(inst.MemBytes)...)
 			}
 
 		default:
@@ -332,25 +350,25 @@ SuffixLoop:
 				switch inst.MemBytes {
 				default:
 					if (inst.Op == FLD || inst.Op == FSTP) && isMem(inst.Args[0]) {
-						op += "t"
+						op = append(op, 't')
 					}
 				case 4:
 					if isFloatInt(inst.Op) {
-						op += "l"
+						op = append(op, 'l')
 					} else {
-						op += "s"
+						op = append(op, 's')
 					}
 				case 8:
 					if isFloatInt(inst.Op) {
-						op += "ll"
+						op = append(op, "ll"...)
 					} else {
-						op += "l"
+						op = append(op, 'l')
 					}
 				}
 				break
 			}
 
-			op += byteSizeSuffix(inst.MemBytes)
+			op = append(op, byteSizeSuffix(inst.MemBytes)...)
 		}
 	}
 
@@ -358,40 +376,48 @@ SuffixLoop:
 	switch inst.Op {
 	case 0:
 		if inst.Prefix[0] != 0 {
-			return strings.ToLower(inst.Prefix[0].String())
+			start := len(dst)
+			dst = appendLower(dst, inst.Prefix[0].String())
+			ts.mark(TokenPrefix, dst, start)
+			return dst
 		}
 
 	case INT:
 		if inst.Opcode>>24 == 0xCC {
 			inst.Args[0] = nil
-			op = "int3"
+			op = append(op[:0], "int3"...)
 		}
 
 	case CMPPS, CMPPD, CMPSD_XMM, CMPSS:
 		imm, ok := inst.Args[2].(Imm)
 		if ok && 0 <= imm && imm < 8 {
 			inst.Args[2] = nil
-			op = cmppsOps[imm] + op[3:]
+			var buf [32]byte
+			op = append(append(buf[:0], cmppsOps[imm]...), op[3:]...)
 		}
 
 	case PCLMULQDQ:
 		imm, ok := inst.Args[2].(Imm)
 		if ok && imm&^0x11 == 0 {
 			inst.Args[2] = nil
-			op = pclmulqOps[(imm&0x10)>>3|(imm&1)]
+			op = append(op[:0], pclmulqOps[(imm&0x10)>>3|(imm&1)]...)
 		}
 
 	case XLATB:
 		if markLastImplicit(&inst, PrefixAddrSize) {
-			op = "xlat" // not xlatb
+			op = append(op[:0], "xlat"...) // not xlatb
 		}
 	}
 
-	// Build list of argument strings.
+	// Format the arguments into argBuf, recording where each ends.
 	var (
-		usedPrefixes bool     // segment prefixes consumed by Mem formatting
-		args         []string // formatted arguments
+		usedPrefixes bool // segment prefixes consumed by Mem formatting
+		argBuf       [128]byte
+		argEnd       [len(inst.Args)]int
+		argToks      [len(inst.Args)]tokens
+		nargs        int
 	)
+	args := argBuf[:0]
 	for i, a := range inst.Args {
 		if a == nil {
 			break
@@ -407,25 +433,18 @@ SuffixLoop:
 		if a == Imm(1) && (inst.Opcode>>24)&^1 == 0xD0 {
 			continue
 		}
-		args = append(args, gnuArg(&inst, pc, symname, a, &usedPrefixes))
-	}
-
-	// The default is to print the arguments in reverse Intel order.
-	// A few instructions inhibit this behavior.
-	switch inst.Op {
-	case BOUND, LCALL, ENTER, LJMP:
-		// no reverse
-	default:
-		// reverse args
-		for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
-			args[i], args[j] = args[j], args[i]
+		var at *tokens
+		if ts != nil {
+			at = &argToks[nargs]
 		}
+		args = appendGNUArg(args, &inst, pc, symname, a, &usedPrefixes, at)
+		argEnd[nargs] = len(args)
+		nargs++
 	}
 
-	// Build prefix string.
+	// Write the prefixes.
 	// Must be after argument formatting, which can turn off segment prefixes.
 	var (
-		prefix       = "" // output string
 		numAddr      = 0
 		numData      = 0
 		implicitData = false
@@ -442,24 +461,31 @@ SuffixLoop:
 		if p&PrefixImplicit != 0 {
 			continue
 		}
+		start := len(dst)
 		switch p &^ (PrefixIgnored | PrefixInvalid) {
 		default:
 			if p.IsREX() {
-				if p&0xFF == PrefixREX {
-					prefix += "rex "
-				} else {
-					prefix += "rex." + p.String()[4:] + " "
+				dst = append(dst, "rex"...)
+				if p&0xFF != PrefixREX {
+					dst = append(dst, '.')
+					dst = appendREXBits(dst, p)
 				}
+				ts.mark(TokenPrefix, dst, start)
+				dst = append(dst, ' ')
+				ts.text(" ")
 				break
 			}
-			prefix += strings.ToLower(p.String()) + " "
+			dst = appendLower(dst, p.String())
+			ts.mark(TokenPrefix, dst, start)
+			dst = append(dst, ' ')
+			ts.text(" ")
 
 		case PrefixPN:
-			op += ",pn"
+			op = append(op, ",pn"...)
 			continue
 
 		case PrefixPT:
-			op += ",pt"
+			op = append(op, ",pt"...)
 			continue
 
 		case PrefixAddrSize, PrefixAddr16, PrefixAddr32:
@@ -475,7 +501,11 @@ SuffixLoop:
 			if countPrefix(&inst, PrefixAddrSize) > numAddr {
 				n = inst.Mode
 			}
-			prefix += fmt.Sprintf("addr%d ", n)
+			dst = append(dst, "addr"...)
+			dst = strconv.AppendInt(dst, int64(n), 10)
+			ts.mark(TokenPrefix, dst, start)
+			dst = append(dst, ' ')
+			ts.text(" ")
 			continue
 
 		case PrefixData16, PrefixData32:
@@ -494,32 +524,124 @@ SuffixLoop:
 						n = 32
 					}
 				}
-				prefix += fmt.Sprintf("data%d ", n)
+				dst = append(dst, "data"...)
+				dst = strconv.AppendInt(dst, int64(n), 10)
+				ts.mark(TokenPrefix, dst, start)
+				dst = append(dst, ' ')
+				ts.text(" ")
 				continue
 			}
-			prefix += strings.ToLower(p.String()) + " "
+			dst = appendLower(dst, p.String())
+			ts.mark(TokenPrefix, dst, start)
+			dst = append(dst, ' ')
+			ts.text(" ")
 		}
 	}
 
 	// Finally! Put it all together.
-	text := prefix + op
-	if args != nil {
-		text += " "
+	dst = append(dst, op...)
+	ts.mark(TokenMnemonic, op, 0)
+	if nargs > 0 {
+		dst = append(dst, ' ')
+		ts.text(" ")
 		// Indirect call/jmp gets a star to distinguish from direct jump address.
 		if (inst.Op == CALL || inst.Op == JMP || inst.Op == LJMP || inst.Op == LCALL) && (isMem(inst.Args[0]) || isReg(inst.Args[0])) {
-			text += "*"
+			dst = append(dst, '*')
+			ts.text("*")
 		}
-		text += strings.Join(args, ",")
+
+		// The default is to print the arguments in reverse Intel order.
+		// A few instructions inhibit this behavior.
+		reverse := true
+		switch inst.Op {
+		case BOUND, LCALL, ENTER, LJMP:
+			reverse = false
+		}
+		for k := 0; k < nargs; k++ {
+			i := k
+			if reverse {
+				i = nargs - 1 - k
+			}
+			start := 0
+			if i > 0 {
+				start = argEnd[i-1]
+			}
+			if k > 0 {
+				dst = append(dst, ',')
+				ts.text(",")
+			}
+			dst = append(dst, args[start:argEnd[i]]...)
+			if ts != nil {
+				ts.addAll(argToks[i])
+			}
+		}
+	}
+	return dst
+}
+
+// appendLower appends s, converted to lower case, to dst.
+// It is strings.ToLower for the ASCII names of ops and prefixes,
+// without allocating.
+func appendLower(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// appendREXBits appends the names of the bits set in the REX prefix p,
+// as in the "WB" of "REX.WB", to dst.
+func appendREXBits(dst []byte, p Prefix) []byte {
+	if p&PrefixREXW != 0 {
+		dst = append(dst, 'W')
+	}
+	if p&PrefixREXR != 0 {
+		dst = append(dst, 'R')
+	}
+	if p&PrefixREXX != 0 {
+		dst = append(dst, 'X')
 	}
-	return text
+	if p&PrefixREXB != 0 {
+		dst = append(dst, 'B')
+	}
+	return dst
+}
+
+// appendHex appends x in the %#x format of package fmt to dst.
+func appendHex(dst []byte, x uint64) []byte {
+	return strconv.AppendUint(append(dst, "0x"...), x, 16)
 }
 
-// gnuArg returns the GNU syntax for the argument x from the instruction inst.
+// appendHexInt appends x in the %#x format of package fmt to dst.
+func appendHexInt(dst []byte, x int64) []byte {
+	if x < 0 {
+		return appendHex(append(dst, '-'), -uint64(x))
+	}
+	return appendHex(dst, uint64(x))
+}
+
+// appendSignedHex appends x in the %+#x format of package fmt to dst.
+func appendSignedHex(dst []byte, x int64) []byte {
+	if x >= 0 {
+		dst = append(dst, '+')
+	}
+	return appendHexInt(dst, x)
+}
+
+// appendGNUArg appends the GNU syntax for the argument x from the
+// instruction inst to dst and, if ts is not nil, adds its tokens to ts.
 // If *usedPrefixes is false and x is a Mem, then the formatting
 // includes any segment prefixes and sets *usedPrefixes to true.
-func gnuArg(inst *Inst, pc uint64, symname SymLookup, x Arg, usedPrefixes *bool) string {
+func appendGNUArg(dst []byte, inst *Inst, pc uint64, symname SymLookup, x Arg, usedPrefixes *bool, ts *tokens) []byte {
+	start := len(dst)
 	if x == nil {
-		return "<nil>"
+		dst = append(dst, "<nil>"...)
+		ts.mark(TokenText, dst, start)
+		return dst
 	}
 	switch x := x.(type) {
 	case Reg:
@@ -532,21 +654,33 @@ func gnuArg(inst *Inst, pc uint64, symname SymLookup, x Arg, usedPrefixes *bool)
 		case IN, INSB, INSW, INSD, OUT, OUTSB, OUTSW, OUTSD:
 			// DX is the port, but libopcodes prints it as if it were a memory reference.
 			if x == DX {
-				return "(%dx)"
+				dst = append(dst, "(%dx)"...)
+				if ts != nil {
+					ts.addAll(tokens{{TokenText, "("}, {TokenRegister, "%dx"}, {TokenText, ")"}})
+				}
+				return dst
 			}
 		case VMOVDQA, VMOVDQU, VMOVNTDQA, VMOVNTDQ:
-			return strings.Replace(gccRegName[x], "xmm", "ymm", -1)
+			if s := gccRegName[x]; strings.HasPrefix(s, "%xmm") {
+				dst = append(append(dst, "%ymm"...), s[len("%xmm"):]...)
+				ts.mark(TokenRegister, dst, start)
+				return dst
+			}
 		}
-		return gccRegName[x]
+		dst = append(dst, gccRegName[x]...)
+		ts.mark(TokenRegister, dst, start)
+		return dst
 	case Mem:
 		if s, disp := memArgToSymbol(x, pc, inst.Len, symname); s != "" {
-			suffix := ""
+			dst = append(dst, s...)
+			ts.mark(TokenSymbol, dst, start)
 			if disp != 0 {
-				suffix = fmt.Sprintf("%+d", disp)
+				start = len(dst)
+				dst = appendSignedDec(dst, disp)
+				ts.mark(TokenImmediate, dst, start)
 			}
-			return fmt.Sprintf("%s%s", s, suffix)
+			return dst
 		}
-		seg := ""
 		var haveCS, haveDS, haveES, haveFS, haveGS, haveSS bool
 		switch x.Segment {
 		case CS:
@@ -610,32 +744,39 @@ func gnuArg(inst *Inst, pc uint64, symname SymLookup, x Arg, usedPrefixes *bool)
 			*usedPrefixes = true
 		}
 		if haveCS {
-			seg += "%cs:"
+			dst = appendGNUSeg(dst, "%cs", ts)
 		}
 		if haveDS {
-			seg += "%ds:"
+			dst = appendGNUSeg(dst, "%ds", ts)
 		}
 		if haveSS {
-			seg += "%ss:"
+			dst = appendGNUSeg(dst, "%ss", ts)
 		}
 		if haveES {
-			seg += "%es:"
+			dst = appendGNUSeg(dst, "%es", ts)
 		}
 		if haveFS {
-			seg += "%fs:"
+			dst = appendGNUSeg(dst, "%fs", ts)
 		}
 		if haveGS {
-			seg += "%gs:"
+			dst = appendGNUSeg(dst, "%gs", ts)
 		}
-		disp := ""
 		if x.Disp != 0 {
-			disp = fmt.Sprintf("%#x", x.Disp)
+			start = len(dst)
+			dst = appendHexInt(dst, x.Disp)
+			ts.mark(TokenImmediate, dst, start)
 		}
 		if x.Scale == 0 || x.Index == 0 && x.Scale == 1 && (x.Base == ESP || x.Base == RSP || x.Base == 0 && inst.Mode == 64) {
 			if x.Base == 0 {
-				return seg + disp
+				return dst
 			}
-			return fmt.Sprintf("%s%s(%s)", seg, disp, gccRegName[x.Base])
+			dst = append(dst, '(')
+			ts.text("(")
+			start = len(dst)
+			dst = append(dst, gccRegName[x.Base]...)
+			ts.mark(TokenRegister, dst, start)
+			ts.text(")")
+			return append(dst, ')')
 		}
 		base := gccRegName[x.Base]
 		if x.Base == 0 {
@@ -649,37 +790,91 @@ func gnuArg(inst *Inst, pc uint64, symname SymLookup, x Arg, usedPrefixes *bool)
 				index = "%eiz"
 			}
 		}
+		dst = append(dst, '(')
+		ts.text("(")
+		start = len(dst)
+		dst = append(dst, base...)
+		ts.mark(TokenRegister, dst, start)
+		dst = append(dst, ',')
+		ts.text(",")
+		start = len(dst)
+		dst = append(dst, index...)
+		ts.mark(TokenRegister, dst, start)
 		if AX <= x.Base && x.Base <= DI {
 			// 16-bit addressing - no scale
-			return fmt.Sprintf("%s%s(%s,%s)", seg, disp, base, index)
+			ts.text(")")
+			return append(dst, ')')
 		}
-		return fmt.Sprintf("%s%s(%s,%s,%d)", seg, disp, base, index, x.Scale)
+		dst = append(dst, ',')
+		ts.text(",")
+		start = len(dst)
+		dst = strconv.AppendUint(dst, uint64(x.Scale), 10)
+		ts.mark(TokenImmediate, dst, start)
+		ts.text(")")
+		return append(dst, ')')
 	case Rel:
 		if pc == 0 {
-			return fmt.Sprintf(".%+#x", int64(x))
+			dst = appendSignedHex(append(dst, '.'), int64(x))
+			ts.mark(TokenAddress, dst, start)
+			return dst
 		} else {
 			addr := pc + uint64(inst.Len) + uint64(x)
 			if s, base := symname(addr); s != "" && addr == base {
-				return fmt.Sprintf("%s", s)
+				dst = append(dst, s...)
+				ts.mark(TokenSymbol, dst, start)
+				return dst
 			} else {
-				addr := pc + uint64(inst.Len) + uint64(x)
-				return fmt.Sprintf("%#x", addr)
+				dst = appendHex(dst, addr)
+				ts.mark(TokenAddress, dst, start)
+				return dst
 			}
 		}
 	case Imm:
 		if s, base := symname(uint64(x)); s != "" {
-			suffix := ""
+			dst = append(dst, '$')
+			ts.text("$")
+			start = len(dst)
+			dst = append(dst, s...)
+			ts.mark(TokenSymbol, dst, start)
 			if uint64(x) != base {
-				suffix = fmt.Sprintf("%+d", uint64(x)-base)
+				start = len(dst)
+				dst = append(dst, '+')
+				dst = strconv.AppendUint(dst, uint64(x)-base, 10)
+				ts.mark(TokenImmediate, dst, start)
 			}
-			return fmt.Sprintf("$%s%s", s, suffix)
+			return dst
 		}
 		if inst.Mode == 32 {
-			return fmt.Sprintf("$%#x", uint32(x))
+			dst = appendHex(append(dst, '$'), uint64(uint32(x)))
+		} else {
+			dst = appendHexInt(append(dst, '$'), int64(x))
 		}
-		return fmt.Sprintf("$%#x", int64(x))
+		ts.mark(TokenImmediate, dst, start)
+		return dst
+	}
+	dst = append(dst, x.String()...)
+	ts.mark(TokenText, dst, start)
+	return dst
+}
+
+// appendGNUSeg appends the segment override seg: to dst
+// and, if ts is not nil, adds its tokens to ts.
+func appendGNUSeg(dst []byte, seg string, ts *tokens) []byte {
+	dst = append(dst, seg...)
+	dst = append(dst, ':')
+	if ts != nil {
+		ts.add(TokenRegister, seg)
+		ts.text(":")
+	}
+	return dst
+}
+
+// appendSignedDec appends x in the %+d format of package fmt to dst.
+func appendSignedDec(dst []byte, x int64) []byte {
+	if x >= 0 {
+		dst = append(dst, '+')
 	}
-	return x.String()
+	return strconv.AppendInt(dst, x, 10)
 }
 
 var gccRegName = [...]string{
@@ -121,6 +121,11 @@ type Op uint32
 
 func (op Op) String() string {
 	i := int(op)
+	if i >= len(opNames) {
+		if name := extOpName(op); name != "" {
+			return name
+		}
+	}
 	if i < 0 || i >= len(opNames) || opNames[i] == "" {
 		return fmt.Sprintf("Op(%d)", i)
 	}
@@ -417,6 +422,53 @@ func (i Inst) String() string {
 	return buf.String()
 }
 
+// MemArgBytes returns the number of bytes of memory accessed through
+// the memory argument i.Args[n]. The result accounts for the operand size
+// and vector length in effect for the instruction, so for example the
+// memory argument of ADD r/m32 is 2 bytes wide when a 66 prefix is present.
+// MemArgBytes returns 0 if i.Args[n] is not a Mem, if the instruction only
+// computes an address (LEA) or uses it as a hint (PREFETCH, CLFLUSH),
+// or if the width depends on processor state (XSAVE).
+func (i Inst) MemArgBytes(n int) int {
+	if n < 0 || n >= len(i.Args) || !isMem(i.Args[n]) {
+		return 0
+	}
+	if i.MemBytes != 0 {
+		return i.MemBytes
+	}
+	switch i.Op {
+	case CMPSB, INSB, LODSB, MOVSB, OUTSB, SCASB, STOSB, XLATB:
+		return 1
+	case CMPSW, INSW, LODSW, MOVSW, OUTSW, SCASW, STOSW:
+		return 2
+	case CMPSD, INSD, LODSD, MOVSD, OUTSD, SCASD, STOSD:
+		return 4
+	case CMPSQ, LODSQ, MOVSQ, SCASQ, STOSQ:
+		return 8
+	case FLD, FSTP, FBLD, FBSTP:
+		// The 32- and 64-bit forms set MemBytes; these are the 80-bit forms.
+		return 10
+	case LGDT, LIDT, SGDT, SIDT:
+		if i.Mode == 64 {
+			return 2 + 8
+		}
+		return 2 + 4
+	case FLDENV, FNSTENV:
+		if i.DataSize == 16 {
+			return 14
+		}
+		return 28
+	case FRSTOR, FNSAVE:
+		if i.DataSize == 16 {
+			return 94
+		}
+		return 108
+	case FXSAVE, FXSAVE64, FXRSTOR, FXRSTOR64:
+		return 512
+	}
+	return 0
+}
+
 func isReg(a Arg) bool {
 	_, ok := a.(Reg)
 	return ok
@@ -18,3 +18,38 @@ func TestRegString(t *testing.T) {
 		}
 	}
 }
+
+func TestMemArgBytes(t *testing.T) {
+	var tests = []struct {
+		mode int
+		enc  []byte
+		arg  int
+		want int
+	}{
+		{64, []byte{0x01, 0x00}, 0, 4},              // add [rax], eax
+		{64, []byte{0x66, 0x01, 0x00}, 0, 2},        // add [rax], ax
+		{64, []byte{0x48, 0x01, 0x00}, 0, 8},        // add [rax], rax
+		{64, []byte{0x48, 0x01, 0x00}, 1, 0},        // register argument
+		{64, []byte{0x8d, 0x00}, 1, 0},              // lea eax, [rax]
+		{64, []byte{0x66, 0xa5}, 1, 2},              // movsw
+		{64, []byte{0xdb, 0x28}, 0, 10},             // fld tbyte [rax]
+		{32, []byte{0x0f, 0x01, 0x10}, 0, 6},        // lgdt [eax]
+		{64, []byte{0x0f, 0x01, 0x10}, 0, 10},       // lgdt [rax]
+		{64, []byte{0x66, 0xd9, 0x20}, 0, 14},       // fldenv [rax]
+		{64, []byte{0x0f, 0xae, 0x00}, 0, 512},      // fxsave [rax]
+		{64, []byte{0x48, 0x0f, 0xc7, 0x08}, 0, 16}, // cmpxchg16b [rax]
+		{64, []byte{0xc5, 0xfe, 0x6f, 0x00}, 1, 32}, // vmovdqu ymm0, [rax]
+		{64, []byte{0x66, 0x0f, 0x6f, 0x00}, 1, 16}, // movdqa xmm0, [rax]
+		{64, []byte{0x0f, 0x18, 0x00}, 0, 0},        // prefetchnta [rax]
+	}
+	for _, tt := range tests {
+		inst, err := Decode(tt.enc, tt.mode)
+		if err != nil {
+			t.Errorf("Decode(% x): %v", tt.enc, err)
+			continue
+		}
+		if got := inst.MemArgBytes(tt.arg); got != tt.want {
+			t.Errorf("%v: MemArgBytes(%d) = %d, want %d", inst, tt.arg, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+// InstLen returns the length of the instruction at the start of src
+// in the given processor mode (16, 32, or 64), without decoding its
+// operands. It is much faster than Decode, for callers such as linear
+// sweeps that need only instruction boundaries.
+//
+// For every instruction that Decode recognizes, InstLen returns the
+// same length as Decode, except after a VEX prefix: Decode reads
+// further legacy prefixes there and decodes an opcode with no VEX
+// form as if the VEX prefix were absent, while InstLen, like the
+// processor, takes the next byte as an opcode in the map the VEX
+// prefix selects. InstLen does not check that the instruction
+// is valid: for a byte sequence that Decode rejects, it returns the
+// length the sequence would have if its opcode were defined, following
+// the general encoding rules. It returns ErrTruncated if src ends
+// before the instruction does or the instruction would be longer
+// than 15 bytes, and ErrInvalidMode for an invalid mode. InstLen does
+// not consult extensions, whose instructions may be of any length.
+func InstLen(src []byte, mode int) (int, error) {
+	e, err := layout(src, mode)
+	return e.len, err
+}
+
+// An encoding describes the parts of an encoded instruction.
+type encoding struct {
+	len    int // total length
+	opMap  int // opcode map: -1 for the one-byte map; 1, 2 or 3 for 0F, 0F38 and 0F3A
+	op     int // index of the opcode byte
+	modrm  int // index of the ModR/M byte, or -1 if there is none
+	disp   int // size of the displacement
+	imm    int // size of the immediate, relative offset or far pointer
+	vexLen int // size of the VEX or EVEX prefix, or 0 if there is none
+}
+
+// layout returns the encoding of the instruction at the start of src,
+// following the rules described in the InstLen doc comment.
+func layout(src []byte, mode int) (encoding, error) {
+	switch mode {
+	case 16, 32, 64:
+	default:
+		return encoding{}, ErrInvalidMode
+	}
+	if len(src) > 15 {
+		src = src[:15]
+	}
+
+	var (
+		pos      int
+		dataSize = 4 // operand size in bytes, for immediates: 2 or 4
+		addrSize = mode / 8
+		rexW     bool
+		vexMap   = -1 // opcode map selected by VEX or EVEX: 1, 2 or 3
+	)
+	if mode == 16 {
+		dataSize = 2
+	}
+
+	// VEX and EVEX prefixes, which Decode accepts only at the start.
+	// Outside 64-bit mode, C4, C5 and 62 with mod != 3 in the next
+	// byte are LES, LDS and BOUND.
+	if len(src) >= 2 && (mode == 64 || mode == 32 && src[1]&0xC0 == 0xC0) {
+		switch src[0] {
+		case 0xC5:
+			vexMap, pos = 1, 2
+		case 0xC4:
+			if len(src) < 3 {
+				return encoding{}, ErrTruncated
+			}
+			vexMap, pos = int(src[1]&0x03), 3 // as Decode, ignore the reserved map bits
+			rexW = src[2]&0x80 != 0
+		case 0x62:
+			if len(src) < 4 {
+				return encoding{}, ErrTruncated
+			}
+			vexMap, pos = int(src[1]&0x03), 4
+		}
+	}
+
+	vexLen := pos
+	if vexMap < 0 {
+	Prefixes:
+		for ; pos < len(src); pos++ {
+			if mode == 64 && src[pos]&0xF0 == 0x40 {
+				// The processor ignores a REX prefix that is
+				// not right before the opcode.
+				rexW = src[pos]&0x08 != 0
+				continue
+			}
+			switch src[pos] {
+			default:
+				break Prefixes
+			case 0xF0, 0xF2, 0xF3, 0x26, 0x2E, 0x36, 0x3E, 0x64, 0x65:
+			case 0x66:
+				if mode == 16 {
+					dataSize = 4
+				} else {
+					dataSize = 2
+				}
+			case 0x67:
+				if mode == 32 {
+					addrSize = 2
+				} else {
+					addrSize = 4
+				}
+			}
+			rexW = false
+		}
+		if rexW {
+			dataSize = 4 // REX.W overrides 66
+		}
+		if pos < len(src) && src[pos] == 0x0F {
+			pos++
+			vexMap = 1
+			if pos < len(src) && (src[pos] == 0x38 || src[pos] == 0x3A) {
+				vexMap = 2 + int(src[pos]&2)>>1
+				pos++
+			}
+		}
+	}
+	if pos >= len(src) {
+		return encoding{}, ErrTruncated
+	}
+	e := encoding{opMap: vexMap, op: pos, modrm: -1, vexLen: vexLen}
+	op := src[pos]
+	pos++
+
+	var modrm bool
+	switch vexMap {
+	case -1:
+		modrm = oneByteModRM[op>>4]&(1<<(op&15)) != 0
+		e.imm = oneByteImm(op, dataSize, addrSize, rexW, mode)
+		if (op == 0xF6 || op == 0xF7) && pos < len(src) && src[pos]>>3&7 < 2 {
+			e.imm = 1 // TEST r/m, imm
+			if op == 0xF7 {
+				e.imm = dataSize
+			}
+		}
+	case 1:
+		modrm = twoByteModRM[op>>4]&(1<<(op&15)) != 0
+		switch op {
+		case 0x0F, 0x70, 0x71, 0x72, 0x73, 0xA4, 0xAC, 0xBA, 0xC2, 0xC4, 0xC5, 0xC6:
+			e.imm = 1
+		}
+		if op&0xF0 == 0x80 {
+			e.imm = dataSize // Jcc rel16/32
+			if mode == 64 {
+				e.imm = 4
+			}
+		}
+	case 2:
+		modrm = true
+	case 3:
+		modrm, e.imm = true, 1
+	default:
+		return encoding{}, ErrUnrecognized
+	}
+
+	if modrm {
+		if pos >= len(src) {
+			return encoding{}, ErrTruncated
+		}
+		e.modrm = pos
+		m := src[pos]
+		pos++
+		mod, rm := m>>6, m&7
+		switch {
+		case mod == 3:
+		case addrSize == 2:
+			switch {
+			case mod == 0 && rm == 6:
+				e.disp = 2
+			case mod == 1:
+				e.disp = 1
+			case mod == 2:
+				e.disp = 2
+			}
+		default:
+			if rm == 4 {
+				if pos >= len(src) {
+					return encoding{}, ErrTruncated
+				}
+				if mod == 0 && src[pos]&7 == 5 {
+					e.disp = 4
+				}
+				pos++
+			}
+			switch {
+			case mod == 0 && rm == 5:
+				e.disp = 4
+			case mod == 1:
+				e.disp = 1
+			case mod == 2:
+				e.disp = 4
+			}
+		}
+	}
+	pos += e.disp + e.imm
+	if pos > len(src) {
+		return encoding{}, ErrTruncated
+	}
+	e.len = pos
+	return e, nil
+}
+
+// oneByteModRM and twoByteModRM record which opcodes in the
+// one-byte map and the 0F map take a ModR/M byte. Entry i has
+// bit j set if opcode i<<4|j does.
+var oneByteModRM = [16]uint16{
+	0x0: 0x0F0F,
+	0x1: 0x0F0F,
+	0x2: 0x0F0F,
+	0x3: 0x0F0F,
+	0x6: 0x0A0C, // 62, 63, 69, 6B
+	0x8: 0xFFFF,
+	0xC: 0x00F3, // C0, C1, C4-C7
+	0xD: 0xFF0F, // D0-D3, D8-DF
+	0xF: 0xC0C0, // F6, F7, FE, FF
+}
+
+var twoByteModRM = [16]uint16{
+	0x0: 0xA00F, // 00-03, 0D, 0F
+	0x1: 0xFFFF,
+	0x2: 0xFF5F, // 20-24, 26, 28-2F
+	0x4: 0xFFFF,
+	0x5: 0xFFFF,
+	0x6: 0xFFFF,
+	0x7: 0xFF7F, // all but 77
+	0x9: 0xFFFF,
+	0xA: 0xF838, // A3-A5, AB-AF
+	0xB: 0xFFFF,
+	0xC: 0x00FF, // C0-C7
+	0xD: 0xFFFF,
+	0xE: 0xFFFF,
+	0xF: 0xFFFF,
+}
+
+// oneByteImm returns the size of the immediate, displacement or
+// far pointer following a one-byte opcode and its ModR/M byte.
+// F6 and F7, whose immediate depends on the ModR/M byte,
+// are handled by the caller.
+func oneByteImm(op byte, dataSize, addrSize int, rexW bool, mode int) int {
+	switch {
+	case op < 0x40 && op&7 == 4, // ALU AL, imm8
+		op&0xF0 == 0x70, // Jcc rel8
+		op&0xF8 == 0xB0, // MOV r8, imm8
+		op&0xFC == 0xE0, // LOOP, JCXZ
+		op&0xFC == 0xE4: // IN, OUT imm8
+		return 1
+	case op < 0x40 && op&7 == 5: // ALU eAX, imm
+		return dataSize
+	case op&0xF8 == 0xB8: // MOV r, imm
+		if rexW {
+			return 8
+		}
+		return dataSize
+	case op&0xFC == 0xA0: // MOV moffs
+		return addrSize
+	}
+	switch op {
+	case 0x6A, 0x6B, 0x80, 0x82, 0x83, 0xA8, 0xC0, 0xC1, 0xC6, 0xCD, 0xD4, 0xD5, 0xEB:
+		return 1
+	case 0x68, 0x69, 0x81, 0xA9, 0xC7:
+		return dataSize
+	case 0xE8, 0xE9:
+		if mode == 64 {
+			return 4
+		}
+		return dataSize
+	case 0xC2, 0xCA:
+		return 2
+	case 0xC8:
+		return 3
+	case 0x9A, 0xEA:
+		return 2 + dataSize
+	}
+	return 0
+}
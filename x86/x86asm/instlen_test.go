@@ -0,0 +1,124 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// checkInstLen reports whether InstLen agrees with Decode on src.
+func checkInstLen(t *testing.T, src []byte, mode int) {
+	inst, err := Decode(src, mode)
+	if err != nil || inst.Op == 0 {
+		return
+	}
+	// Skip the VEX-prefixed sequences that Decode reads differently
+	// from the processor; see InstLen. All VEX Ops begin with V.
+	if vex := inst.Prefix[0] & 0xFF; vex == 0xC4 || vex == 0xC5 {
+		n := 2
+		if vex == 0xC4 {
+			n = 3
+		}
+		if inst.Prefix[n] != 0 || !strings.HasPrefix(inst.Op.String(), "V") {
+			return
+		}
+	}
+	n, err := InstLen(src, mode)
+	if n != inst.Len || err != nil {
+		t.Errorf("InstLen(% x, %d) = %d, %v, want %d (%s)", src, mode, n, err, inst.Len, GNUSyntax(inst, 0, nil))
+	}
+}
+
+func TestInstLen(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 2 || strings.HasPrefix(f[0], "#") {
+			continue
+		}
+		code, err := hex.DecodeString(strings.Replace(f[0], "|", "", 1))
+		if err != nil {
+			t.Fatalf("parsing %q: %v", f[0], err)
+		}
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Fatalf("invalid mode %q", f[1])
+		}
+		checkInstLen(t, code, mode)
+	}
+}
+
+func TestInstLenRandom(t *testing.T) {
+	n := 100000
+	if testing.Short() {
+		n = 10000
+	}
+	r := rand.New(rand.NewSource(1))
+	src := make([]byte, 15)
+	for i := 0; i < n; i++ {
+		r.Read(src)
+		for _, mode := range []int{16, 32, 64} {
+			checkInstLen(t, src, mode)
+		}
+		if t.Failed() {
+			break
+		}
+	}
+}
+
+func TestInstLenErrors(t *testing.T) {
+	if _, err := InstLen([]byte{0x90}, 8); err != ErrInvalidMode {
+		t.Errorf("InstLen in mode 8: err = %v, want ErrInvalidMode", err)
+	}
+	for _, hexsrc := range []string{"", "66", "b8010000", "8b", "8b04", "e8000000"} {
+		src, _ := hex.DecodeString(hexsrc)
+		if n, err := InstLen(src, 32); err != ErrTruncated {
+			t.Errorf("InstLen(%s, 32) = %d, %v, want ErrTruncated", hexsrc, n, err)
+		}
+	}
+}
+
+func TestInstLenREX(t *testing.T) {
+	// A REX prefix followed by another prefix is ignored,
+	// and the processor goes on reading prefixes.
+	for _, tt := range []struct {
+		hex string
+		len int
+	}{
+		{"486690", 3},                  // rex.W data16 nop
+		{"484190", 3},                  // rex.W rex.B nop
+		{"4866b80100", 5},              // rex.W mov $0x1,%ax
+		{"6648b80100000000000000", 11}, // movabs $0x1,%rax
+		{"4148b80100000000000000", 11}, // rex.B movabs $0x1,%rax
+		{"48f30fb8c1", 5},              // rex.W popcnt %ecx,%eax
+	} {
+		src, _ := hex.DecodeString(tt.hex)
+		if n, err := InstLen(src, 64); n != tt.len || err != nil {
+			t.Errorf("InstLen(%s, 64) = %d, %v, want %d", tt.hex, n, err, tt.len)
+		}
+	}
+}
+
+func BenchmarkInstLen(b *testing.B) {
+	src := []byte{0x48, 0x8b, 0x84, 0x24, 0x10, 0x01, 0x00, 0x00} // mov 0x110(%rsp),%rax
+	for i := 0; i < b.N; i++ {
+		InstLen(src, 64)
+	}
+}
+
+func BenchmarkDecodeLen(b *testing.B) {
+	src := []byte{0x48, 0x8b, 0x84, 0x24, 0x10, 0x01, 0x00, 0x00}
+	for i := 0; i < b.N; i++ {
+		Decode(src, 64)
+	}
+}
@@ -11,6 +11,16 @@ import (
 
 // IntelSyntax returns the Intel assembler syntax for the instruction, as defined by Intel's XED tool.
 func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	return intelSyntax(inst, pc, symname, false)
+}
+
+// intelSyntax implements IntelSyntax and, if masm is set, MASMSyntax.
+func intelSyntax(inst Inst, pc uint64, symname SymLookup, masm bool) string {
+	return intelTokens(inst, pc, symname, masm).String()
+}
+
+// intelTokens returns the tokens of intelSyntax(inst, pc, symname, masm).
+func intelTokens(inst Inst, pc uint64, symname SymLookup, masm bool) tokens {
 	if symname == nil {
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
@@ -102,7 +112,7 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 		}
 	}
 
-	if isLoop[inst.Op] || inst.Op == JCXZ || inst.Op == JECXZ || inst.Op == JRCXZ {
+	if inst.Op <= maxOp && isLoop[inst.Op] || inst.Op == JCXZ || inst.Op == JECXZ || inst.Op == JRCXZ {
 		for i, p := range inst.Prefix {
 			if p == PrefixPT || p == PrefixPN {
 				inst.Prefix[i] |= PrefixImplicit
@@ -166,7 +176,11 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 		haveBnd
 	)
 	var prefixBits uint32
-	prefix := ""
+	var prefix tokens
+	addPrefix := func(s string) {
+		prefix.add(TokenPrefix, s)
+		prefix.text(" ")
+	}
 	for _, p := range inst.Prefix {
 		if p == 0 {
 			break
@@ -179,13 +193,13 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 		}
 		switch p {
 		default:
-			prefix += strings.ToLower(p.String()) + " "
+			addPrefix(strings.ToLower(p.String()))
 		case PrefixCS, PrefixDS, PrefixES, PrefixFS, PrefixGS, PrefixSS:
 			if inst.Op == 0 {
-				prefix += strings.ToLower(p.String()) + " "
+				addPrefix(strings.ToLower(p.String()))
 			}
 		case PrefixREPN:
-			prefix += "repne "
+			addPrefix("repne")
 		case PrefixLOCK:
 			prefixBits |= haveLock
 		case PrefixData16, PrefixDataSize:
@@ -218,59 +232,61 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 	}
 
 	if prefixBits&haveXacquire != 0 {
-		prefix += "xacquire "
+		addPrefix("xacquire")
 	}
 	if prefixBits&haveXrelease != 0 {
-		prefix += "xrelease "
+		addPrefix("xrelease")
 	}
 	if prefixBits&haveLock != 0 {
-		prefix += "lock "
+		addPrefix("lock")
 	}
 	if prefixBits&haveBnd != 0 {
-		prefix += "bnd "
+		addPrefix("bnd")
 	}
 	if prefixBits&haveHintTaken != 0 {
-		prefix += "hint-taken "
+		addPrefix("hint-taken")
 	}
 	if prefixBits&haveHintNotTaken != 0 {
-		prefix += "hint-not-taken "
+		addPrefix("hint-not-taken")
 	}
 	if prefixBits&haveAddr16 != 0 {
-		prefix += "addr16 "
+		addPrefix("addr16")
 	}
 	if prefixBits&haveAddr32 != 0 {
-		prefix += "addr32 "
+		addPrefix("addr32")
 	}
 	if prefixBits&haveData16 != 0 {
-		prefix += "data16 "
+		addPrefix("data16")
 	}
 	if prefixBits&haveData32 != 0 {
-		prefix += "data32 "
+		addPrefix("data32")
 	}
 
 	if inst.Op == 0 {
-		if prefix == "" {
-			return "<no instruction>"
+		if prefix == nil {
+			return tok(TokenText, "<no instruction>")
 		}
-		return prefix[:len(prefix)-1]
+		return prefix[:len(prefix)-1] // drop the trailing space
 	}
 
-	var args []string
+	var args []tokens
 	for _, a := range iargs {
 		if a == nil {
 			break
 		}
-		args = append(args, intelArg(&inst, pc, symname, a))
+		args = append(args, intelArg(&inst, pc, symname, a, masm))
 	}
+	st0 := intelArg(&inst, pc, symname, F0, masm)
+	st1 := intelArg(&inst, pc, symname, F1, masm)
 
 	var op string
 	switch inst.Op {
 	case NOP:
 		if inst.Opcode>>24 == 0x0F {
 			if inst.DataSize == 16 {
-				args = append(args, "ax")
+				args = append(args, tok(TokenRegister, "ax"))
 			} else {
-				args = append(args, "eax")
+				args = append(args, tok(TokenRegister, "eax"))
 			}
 		}
 
@@ -290,22 +306,22 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 
 	case FCHS, FABS, FTST, FLDPI, FLDL2E, FLDLG2, F2XM1, FXAM, FLD1, FLDL2T, FSQRT, FRNDINT, FCOS, FSIN:
 		if len(args) == 0 {
-			args = append(args, "st0")
+			args = append(args, st0)
 		}
 
 	case FPTAN, FSINCOS, FUCOMPP, FCOMPP, FYL2X, FPATAN, FXTRACT, FPREM1, FPREM, FYL2XP1, FSCALE:
 		if len(args) == 0 {
-			args = []string{"st0", "st1"}
+			args = []tokens{st0, st1}
 		}
 
 	case FST, FSTP, FISTTP, FIST, FISTP, FBSTP:
 		if len(args) == 1 {
-			args = append(args, "st0")
+			args = append(args, st0)
 		}
 
 	case FLD, FXCH, FCOM, FCOMP, FIADD, FIMUL, FICOM, FICOMP, FISUBR, FIDIV, FUCOM, FUCOMP, FILD, FBLD, FADD, FMUL, FSUB, FSUBR, FISUB, FDIV, FDIVR, FIDIVR:
 		if len(args) == 1 {
-			args = []string{"st0", args[0]}
+			args = []tokens{st0, args[0]}
 		}
 
 	case MASKMOVDQU, MASKMOVQ, XLATB, OUTSB, OUTSW, OUTSD:
@@ -315,7 +331,7 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 			switch p {
 			case PrefixCS, PrefixES, PrefixFS, PrefixGS, PrefixSS:
 				if inst.Mode != 64 || p == PrefixFS || p == PrefixGS {
-					args = append(args, strings.ToLower((inst.Prefix[i] & 0xFF).String()))
+					args = append(args, tok(TokenRegister, strings.ToLower((inst.Prefix[i]&0xFF).String())))
 					break FixSegment
 				}
 			case PrefixDS:
@@ -326,19 +342,33 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 		}
 	}
 
-	if op == "" {
+	if op == "" && masm {
+		op = masmOp[inst.Op]
+	} else if op == "" {
 		op = intelOp[inst.Op]
 	}
 	if op == "" {
 		op = strings.ToLower(inst.Op.String())
 	}
-	if args != nil {
-		op += " " + strings.Join(args, ", ")
+	ts := prefix
+	ts.add(TokenMnemonic, op)
+	for i, a := range args {
+		if i == 0 {
+			ts.text(" ")
+		} else {
+			ts.text(", ")
+		}
+		ts.addAll(a)
 	}
-	return prefix + op
+	return ts
 }
 
-func intelArg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
+// intelArg formats arg in Intel syntax, or in MASM syntax if masm is set.
+func intelArg(inst *Inst, pc uint64, symname SymLookup, arg Arg, masm bool) tokens {
+	hex, signedHex := intelHex, intelSignedHex
+	if masm {
+		hex, signedHex = masmHex, masmSignedHex
+	}
 	switch a := arg.(type) {
 	case Imm:
 		if s, base := symname(uint64(a)); s != "" {
@@ -346,15 +376,21 @@ func intelArg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
 			if uint64(a) != base {
 				suffix = fmt.Sprintf("%+d", uint64(a)-base)
 			}
-			return fmt.Sprintf("$%s%s", s, suffix)
+			if masm {
+				return append(tok(TokenText, "offset "), symTokens(s, suffix)...)
+			}
+			return append(tok(TokenText, "$"), symTokens(s, suffix)...)
 		}
 		if inst.Mode == 32 {
-			return fmt.Sprintf("%#x", uint32(a))
+			return tok(TokenImmediate, hex(uint64(uint32(a))))
 		}
 		if Imm(int32(a)) == a {
-			return fmt.Sprintf("%#x", int64(a))
+			if a < 0 {
+				return tok(TokenImmediate, signedHex(int64(a)))
+			}
+			return tok(TokenImmediate, hex(uint64(a)))
 		}
-		return fmt.Sprintf("%#x", uint64(a))
+		return tok(TokenImmediate, hex(uint64(a)))
 	case Mem:
 		if a.Base == EIP {
 			a.Base = RIP
@@ -395,6 +431,9 @@ func intelArg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
 			}
 		case PREFETCHW, PREFETCHNTA, PREFETCHT0, PREFETCHT1, PREFETCHT2, CLFLUSH:
 			prefix = "zmmword "
+			if masm {
+				prefix = "byte "
+			}
 		}
 		switch inst.Op {
 		case MOVSB, MOVSW, MOVSD, MOVSQ, CMPSB, CMPSW, CMPSD, CMPSQ, STOSB, STOSW, STOSD, STOSQ, SCASB, SCASW, SCASD, SCASQ, LODSB, LODSW, LODSD, LODSQ:
@@ -427,59 +466,96 @@ func intelArg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
 			a.Segment = 0
 		}
 
-		prefix += "ptr "
+		ts := tok(TokenText, prefix+"ptr ")
 		if s, disp := memArgToSymbol(a, pc, inst.Len, symname); s != "" {
 			suffix := ""
 			if disp != 0 {
 				suffix = fmt.Sprintf("%+d", disp)
 			}
-			return prefix + fmt.Sprintf("[%s%s]", s, suffix)
+			ts.text("[")
+			ts.addAll(symTokens(s, suffix))
+			ts.text("]")
+			return ts
 		}
 		if a.Segment != 0 {
-			prefix += strings.ToLower(a.Segment.String()) + ":"
-		}
-		prefix += "["
+			ts.add(TokenRegister, strings.ToLower(a.Segment.String()))
+			ts.text(":")
+		} else if masm && a.Base == 0 && a.Index == 0 {
+			// MASM reads [0A0h] as the constant 0A0h.
+			ts.add(TokenRegister, "ds")
+			ts.text(":")
+		}
+		ts.text("[")
+		empty := true
 		if a.Base != 0 {
-			prefix += intelArg(inst, pc, symname, a.Base)
+			ts.addAll(intelArg(inst, pc, symname, a.Base, masm))
+			empty = false
 		}
 		if a.Scale != 0 && a.Index != 0 {
 			if a.Base != 0 {
-				prefix += "+"
+				ts.text("+")
 			}
-			prefix += fmt.Sprintf("%s*%d", intelArg(inst, pc, symname, a.Index), a.Scale)
+			ts.addAll(intelArg(inst, pc, symname, a.Index, masm))
+			ts.text("*")
+			ts.add(TokenImmediate, fmt.Sprint(a.Scale))
+			empty = false
 		}
 		if a.Disp != 0 {
-			if prefix[len(prefix)-1] == '[' && (a.Disp >= 0 || int64(int32(a.Disp)) != a.Disp) {
-				prefix += fmt.Sprintf("%#x", uint64(a.Disp))
+			if empty && (a.Disp >= 0 || int64(int32(a.Disp)) != a.Disp) {
+				ts.add(TokenImmediate, hex(uint64(a.Disp)))
+			} else if a.Disp >= 0 {
+				ts.text("+")
+				ts.add(TokenImmediate, hex(uint64(a.Disp)))
 			} else {
-				prefix += fmt.Sprintf("%+#x", a.Disp)
+				ts.add(TokenImmediate, signedHex(a.Disp))
 			}
 		}
-		prefix += "]"
-		return prefix
+		ts.text("]")
+		return ts
 	case Rel:
 		if pc == 0 {
-			return fmt.Sprintf(".%+#x", int64(a))
+			if masm {
+				// MASM writes the address of the current instruction as $.
+				if a >= 0 {
+					return tok(TokenAddress, "$+"+hex(uint64(a)))
+				}
+				return tok(TokenAddress, "$"+signedHex(int64(a)))
+			}
+			return tok(TokenAddress, fmt.Sprintf(".%+#x", int64(a)))
 		} else {
 			addr := pc + uint64(inst.Len) + uint64(a)
 			if s, base := symname(addr); s != "" && addr == base {
-				return fmt.Sprintf("%s", s)
+				return tok(TokenSymbol, s)
 			} else {
 				addr := pc + uint64(inst.Len) + uint64(a)
-				return fmt.Sprintf("%#x", addr)
+				return tok(TokenAddress, hex(addr))
 			}
 		}
 	case Reg:
+		if masm && int(a) < len(masmReg) && masmReg[a] != "" {
+			return tok(TokenRegister, masmReg[a])
+		}
 		if int(a) < len(intelReg) && intelReg[a] != "" {
 			switch inst.Op {
 			case VMOVDQA, VMOVDQU, VMOVNTDQA, VMOVNTDQ:
-				return strings.Replace(intelReg[a], "xmm", "ymm", -1)
+				return tok(TokenRegister, strings.Replace(intelReg[a], "xmm", "ymm", -1))
 			default:
-				return intelReg[a]
+				return tok(TokenRegister, intelReg[a])
 			}
 		}
+		return tok(TokenRegister, strings.ToLower(arg.String()))
 	}
-	return strings.ToLower(arg.String())
+	return tok(TokenText, strings.ToLower(arg.String()))
+}
+
+// intelHex formats x as a hexadecimal number in Intel syntax.
+func intelHex(x uint64) string {
+	return fmt.Sprintf("%#x", x)
+}
+
+// intelSignedHex formats x as a signed hexadecimal number in Intel syntax.
+func intelSignedHex(x int64) string {
+	return fmt.Sprintf("%#x", x)
 }
 
 var intelOp = map[Op]string{
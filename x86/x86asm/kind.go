@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "golang.org/x/arch/internal/asmarg"
+
+// An ArgKind classifies an instruction argument. The armasm, arm64asm
+// and ppc64asm packages share the same type and kinds, so that code
+// handling several architectures can examine arguments without a type
+// switch for each package.
+type ArgKind = asmarg.Kind
+
+const (
+	KindOther   = asmarg.Other   // none of the other kinds
+	KindReg     = asmarg.Reg     // general-purpose, segment, x87, MMX or vector register
+	KindImm     = asmarg.Imm     // immediate constant
+	KindMem     = asmarg.Mem     // memory reference
+	KindPCRel   = asmarg.PCRel   // PC-relative address (Rel)
+	KindAddr    = asmarg.Addr    // absolute code address; not used on x86
+	KindRegList = asmarg.RegList // register list; not used on x86
+	KindCond    = asmarg.Cond    // condition; not used on x86
+	KindSysReg  = asmarg.SysReg  // descriptor table, control, debug or test register
+)
+
+// A RegRef describes a register, as RegValue returns it.
+// It is the same type in all the disassembler packages.
+type RegRef = asmarg.RegRef
+
+// A MemRef describes a memory reference, as MemValue returns it.
+// It is the same type in all the disassembler packages.
+type MemRef = asmarg.MemRef
+
+// Kind returns KindReg or, for a system register, KindSysReg.
+func (r Reg) Kind() ArgKind {
+	if GDTR <= r && r <= TR7 {
+		return KindSysReg
+	}
+	return KindReg
+}
+
+// Kind returns KindMem.
+func (Mem) Kind() ArgKind { return KindMem }
+
+// Kind returns KindPCRel.
+func (Rel) Kind() ArgKind { return KindPCRel }
+
+// Kind returns KindImm.
+func (Imm) Kind() ArgKind { return KindImm }
+
+// ArgKindOf returns the kind of a, as its Kind method reports it.
+// It returns KindOther for nil.
+func ArgKindOf(a Arg) ArgKind {
+	if k, ok := a.(interface{ Kind() ArgKind }); ok {
+		return k.Kind()
+	}
+	return KindOther
+}
+
+// ImmValue returns the value of a, if a is an immediate constant.
+func ImmValue(a Arg) (int64, bool) {
+	if i, ok := a.(Imm); ok {
+		return int64(i), true
+	}
+	return 0, false
+}
+
+// RegValue returns the register a, if a is a register.
+// Registers without an encoding number, IP, EIP, RIP and
+// the descriptor table registers, have Num -1.
+func RegValue(a Arg) (RegRef, bool) {
+	r, ok := a.(Reg)
+	if !ok {
+		return RegRef{}, false
+	}
+	return RegRef{Name: r.String(), Num: regNum(r)}, true
+}
+
+// regNum returns the number of r in instruction encodings, or -1.
+func regNum(r Reg) int {
+	switch {
+	case AL <= r && r <= BH:
+		return int(r - AL)
+	case SPB <= r && r <= DIB:
+		return 4 + int(r-SPB)
+	case R8B <= r && r <= R15B:
+		return 8 + int(r-R8B)
+	case AX <= r && r <= R15W:
+		return int(r - AX)
+	case EAX <= r && r <= R15L:
+		return int(r - EAX)
+	case RAX <= r && r <= R15:
+		return int(r - RAX)
+	case F0 <= r && r <= F7:
+		return int(r - F0)
+	case M0 <= r && r <= M7:
+		return int(r - M0)
+	case X0 <= r && r <= X15:
+		return int(r - X0)
+	case ES <= r && r <= GS:
+		return int(r - ES)
+	case CR0 <= r && r <= CR15:
+		return int(r - CR0)
+	case DR0 <= r && r <= DR15:
+		return int(r - DR0)
+	case TR0 <= r && r <= TR7:
+		return int(r - TR0)
+	}
+	return -1
+}
+
+// MemValue returns the memory reference inst.Args[i],
+// if that argument is one.
+func MemValue(inst Inst, i int) (MemRef, bool) {
+	m, ok := inst.Args[i].(Mem)
+	if !ok {
+		return MemRef{}, false
+	}
+	ref := MemRef{Disp: m.Disp}
+	if m.Segment != 0 {
+		ref.Segment = m.Segment.String()
+	}
+	if m.Base != 0 {
+		ref.Base = m.Base.String()
+	}
+	if m.Index != 0 {
+		ref.Index = m.Index.String()
+		ref.Scale = int(m.Scale)
+	}
+	return ref, true
+}
+
+// PCRelTarget returns the address that the PC-relative argument
+// of inst refers to, for inst located at pc. The boolean result
+// reports whether inst has a PC-relative argument.
+func PCRelTarget(inst Inst, pc uint64) (uint64, bool) {
+	for _, a := range inst.Args {
+		if r, ok := a.(Rel); ok {
+			return pc + uint64(inst.Len) + uint64(int64(r)), true
+		}
+	}
+	return 0, false
+}
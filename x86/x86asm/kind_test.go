@@ -0,0 +1,97 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestArgKindOf(t *testing.T) {
+	tests := []struct {
+		code  string
+		kinds string
+		imm   int64
+	}{
+		{"\x48\x8b\x44\x24\x08", "Reg Mem", 0}, // mov rax, [rsp+8]
+		{"\xe8\x00\x00\x00\x00", "PCRel", 0},   // call .+5
+		{"\x0f\x20\xc0", "Reg SysReg", 0},      // mov rax, cr0
+		{"\x6a\x05", "Imm", 5},                 // push 5
+	}
+	for _, tt := range tests {
+		inst, err := Decode([]byte(tt.code), 64)
+		if err != nil {
+			t.Fatalf("Decode(% x): %v", tt.code, err)
+		}
+		var kinds []string
+		for _, a := range inst.Args {
+			if a == nil {
+				break
+			}
+			kinds = append(kinds, ArgKindOf(a).String())
+			if v, ok := ImmValue(a); ok && v != tt.imm {
+				t.Errorf("%v: ImmValue(%v) = %d, want %d", inst, a, v, tt.imm)
+			}
+		}
+		if s := strings.Join(kinds, " "); s != tt.kinds {
+			t.Errorf("%v: kinds %q, want %q", inst, s, tt.kinds)
+		}
+	}
+	if k := ArgKindOf(nil); k != KindOther {
+		t.Errorf("ArgKindOf(nil) = %v, want %v", k, KindOther)
+	}
+}
+
+func TestArgValues(t *testing.T) {
+	tests := []struct {
+		code string
+		i    int
+		reg  RegRef
+		mem  MemRef
+	}{
+		{"\x48\x8b\x44\x8b\x08", 0, RegRef{Name: "RAX", Num: 0}, MemRef{}}, // mov rax, [rbx+4*rcx+8]
+		{"\x48\x8b\x44\x8b\x08", 1, RegRef{}, MemRef{Base: "RBX", Index: "RCX", Scale: 4, Disp: 8}},
+		{"\x64\x48\x8b\x04\x25\x28\x00\x00\x00", 1, RegRef{}, MemRef{Segment: "FS", Disp: 0x28}}, // mov rax, fs:[0x28]
+		{"\x8b\x05\x10\x00\x00\x00", 1, RegRef{}, MemRef{Base: "RIP", Disp: 0x10}},               // mov eax, [rip+0x10]
+		{"\x41\x8b\xc1", 1, RegRef{Name: "R9L", Num: 9}, MemRef{}},                               // mov eax, r9d
+		{"\x40\x88\xf0", 1, RegRef{Name: "SIB", Num: 6}, MemRef{}},                               // mov al, sil
+		{"\x0f\x01\x00", 0, RegRef{}, MemRef{Base: "RAX"}},                                       // sgdt [rax]
+	}
+	for _, tt := range tests {
+		inst, err := Decode([]byte(tt.code), 64)
+		if err != nil {
+			t.Fatalf("Decode(% x): %v", tt.code, err)
+		}
+		reg, ok := RegValue(inst.Args[tt.i])
+		if ok != (tt.reg != RegRef{}) || reg != tt.reg {
+			t.Errorf("%v: RegValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, reg, ok, tt.reg)
+		}
+		mem, ok := MemValue(inst, tt.i)
+		if ok != (tt.mem != MemRef{}) || mem != tt.mem {
+			t.Errorf("%v: MemValue(Args[%d]) = %+v, %v, want %+v", inst, tt.i, mem, ok, tt.mem)
+		}
+	}
+}
+
+func TestPCRelTarget(t *testing.T) {
+	tests := []struct {
+		code   string
+		target uint64
+		ok     bool
+	}{
+		{"\xe8\x10\x00\x00\x00", 0x1015, true}, // call .+0x15
+		{"\xeb\xfe", 0x1000, true},             // jmp .
+		{"\xc3", 0, false},                     // ret
+	}
+	for _, tt := range tests {
+		inst, err := Decode([]byte(tt.code), 64)
+		if err != nil {
+			t.Fatalf("Decode(% x): %v", tt.code, err)
+		}
+		if target, ok := PCRelTarget(inst, 0x1000); target != tt.target || ok != tt.ok {
+			t.Errorf("%v: PCRelTarget = %#x, %v, want %#x, %v", inst, target, ok, tt.target, tt.ok)
+		}
+	}
+}
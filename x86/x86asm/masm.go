@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MASMSyntax returns the Microsoft Macro Assembler syntax for the instruction,
+// as also used by the Windows debuggers.
+//
+// MASM syntax is Intel syntax with a few differences: numbers are written
+// in hexadecimal with an h suffix, as in 0FFh, and small numbers in decimal;
+// x87 and MMX registers are named st(0) and mm0; condition codes use the
+// mnemonics from the Intel manual, such as jae and cmovne, rather than
+// XED's canonical forms; and the current instruction's address is $.
+func MASMSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	return intelSyntax(inst, pc, symname, true)
+}
+
+// masmHex formats x as a number in MASM syntax.
+// Numbers below 10 are the same in decimal and hexadecimal
+// and are written without the h suffix.
+func masmHex(x uint64) string {
+	if x < 10 {
+		return strconv.FormatUint(x, 10)
+	}
+	s := strings.ToUpper(strconv.FormatUint(x, 16)) + "h"
+	if s[0] > '9' {
+		// A leading letter would make the number an identifier.
+		s = "0" + s
+	}
+	return s
+}
+
+// masmSignedHex formats x as a signed number in MASM syntax.
+func masmSignedHex(x int64) string {
+	if x < 0 {
+		return "-" + masmHex(-uint64(x))
+	}
+	return masmHex(uint64(x))
+}
+
+// masmOp holds the MASM mnemonics that differ from the lower-case Op name.
+var masmOp = map[Op]string{
+	LCALL:     "call far",
+	LJMP:      "jmp far",
+	LRET:      "retf",
+	ICEBP:     "int1",
+	MOVSD_XMM: "movsd",
+	XLATB:     "xlat",
+}
+
+// masmReg holds the MASM register names that differ from Intel syntax.
+var masmReg = [...]string{
+	F0: "st(0)",
+	F1: "st(1)",
+	F2: "st(2)",
+	F3: "st(3)",
+	F4: "st(4)",
+	F5: "st(5)",
+	F6: "st(6)",
+	F7: "st(7)",
+	M0: "mm0",
+	M1: "mm1",
+	M2: "mm2",
+	M3: "mm3",
+	M4: "mm4",
+	M5: "mm5",
+	M6: "mm6",
+	M7: "mm7",
+}
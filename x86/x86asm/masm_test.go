@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "testing"
+
+var masmTests = []struct {
+	enc  []byte
+	pc   uint64
+	want string
+}{
+	{[]byte{0x48, 0x01, 0x43, 0x08}, 0, "add qword ptr [rbx+8], rax"},
+	{[]byte{0x8b, 0x44, 0x8b, 0xf8}, 0, "mov eax, dword ptr [rbx+rcx*4-8]"},
+	{[]byte{0x48, 0x83, 0xec, 0xf0}, 0, "sub rsp, -10h"},
+	{[]byte{0xb8, 0xff, 0x00, 0x00, 0x00}, 0, "mov eax, 0FFh"},
+	{[]byte{0xb8, 0x10, 0x00, 0x00, 0x00}, 0, "mov eax, 10h"},
+	{[]byte{0x8b, 0x04, 0x25, 0xa0, 0x00, 0x00, 0x00}, 0, "mov eax, dword ptr ds:[0A0h]"},
+	{[]byte{0x64, 0x48, 0x8b, 0x04, 0x25, 0x28, 0x00, 0x00, 0x00}, 0, "mov rax, qword ptr fs:[28h]"},
+	{[]byte{0xe9, 0x10, 0x00, 0x00, 0x00}, 0, "jmp $+10h"},
+	{[]byte{0x0f, 0x83, 0xf0, 0xff, 0xff, 0xff}, 0, "jae $-10h"},
+	{[]byte{0x0f, 0x83, 0xf0, 0xff, 0xff, 0xff}, 0x1000, "jae 0FF6h"},
+	{[]byte{0x48, 0xc7, 0xc0, 0x00, 0x20, 0x00, 0x00}, 0x1000, "mov rax, offset runtime.foo"},
+	{[]byte{0xe8, 0xfb, 0x0f, 0x00, 0x00}, 0x1000, "call runtime.foo"},
+	{[]byte{0xdd, 0xd9}, 0, "fstp st(1), st(0)"},
+	{[]byte{0xd9, 0xe1}, 0, "fabs st(0)"},
+	{[]byte{0x0f, 0x6f, 0xc1}, 0, "movq mm0, mm1"},
+	{[]byte{0x0f, 0x18, 0x08}, 0, "prefetcht0 byte ptr [rax]"},
+	{[]byte{0xcb}, 0, "retf"},
+}
+
+func TestMASMSyntax(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		if addr == 0x2000 {
+			return "runtime.foo", 0x2000
+		}
+		return "", 0
+	}
+	for _, tt := range masmTests {
+		inst, err := Decode(tt.enc, 64)
+		if err != nil {
+			t.Errorf("Decode(% x): %v", tt.enc, err)
+			continue
+		}
+		if out := MASMSyntax(inst, tt.pc, symname); out != tt.want {
+			t.Errorf("MASMSyntax(% x) = %q, want %q", tt.enc, out, tt.want)
+		}
+		var toks []Token
+		MASMSyntaxTokens(inst, tt.pc, symname, func(t Token) { toks = append(toks, t) })
+		if s := joinTokens(toks); s != tt.want {
+			t.Errorf("MASMSyntaxTokens(% x) = %q, want %q", tt.enc, s, tt.want)
+		}
+	}
+}
+
+func TestMASMHex(t *testing.T) {
+	for _, tt := range []struct {
+		x    int64
+		want string
+	}{
+		{0, "0"},
+		{9, "9"},
+		{10, "0Ah"},
+		{0x10, "10h"},
+		{0xff, "0FFh"},
+		{-0x10, "-10h"},
+		{-1, "-1"},
+	} {
+		if s := masmSignedHex(tt.x); s != tt.want {
+			t.Errorf("masmSignedHex(%d) = %q, want %q", tt.x, s, tt.want)
+		}
+	}
+}
@@ -4,10 +4,7 @@
 
 package x86asm
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 type SymLookup func(uint64) (string, uint64)
 
@@ -19,10 +16,15 @@ type SymLookup func(uint64) (string, uint64)
 // being disassembled. Given a target address it returns the name and base
 // address of the symbol containing the target, if any; otherwise it returns "", 0.
 func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	return goTokens(inst, pc, symname).String()
+}
+
+// goTokens returns the tokens of GoSyntax(inst, pc, symname).
+func goTokens(inst Inst, pc uint64, symname SymLookup) tokens {
 	if symname == nil {
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
-	var args []string
+	var args []tokens
 	for i := len(inst.Args) - 1; i >= 0; i-- {
 		a := inst.Args[i]
 		if a == nil {
@@ -45,24 +47,29 @@ func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
 		// Only REP and REPN are recognized repeaters. Plan 9 syntax
 		// treats them as separate opcodes.
 		case p&0xFF == PrefixREP:
-			rep = "REP; "
+			rep = "REP"
 		case p&0xFF == PrefixREPN:
-			rep = "REPNE; "
+			rep = "REPNE"
 		default:
 			last = p
 		}
 	}
 
-	prefix := ""
+	var ts tokens
+	if rep != "" {
+		ts.add(TokenPrefix, rep)
+		ts.text("; ")
+	}
 	switch last & 0xFF {
 	case 0, 0x66, 0x67:
 		// ignore
 	default:
-		prefix += last.String() + " "
+		ts.add(TokenPrefix, last.String())
+		ts.text(" ")
 	}
 
 	op := inst.Op.String()
-	if plan9Suffix[inst.Op] {
+	if inst.Op <= maxOp && plan9Suffix[inst.Op] {
 		s := inst.DataSize
 		if inst.MemBytes != 0 {
 			s = inst.MemBytes * 8
@@ -89,20 +96,25 @@ func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
 		args[0], args[1] = args[1], args[0]
 	}
 
-	if args != nil {
-		op += " " + strings.Join(args, ", ")
+	ts.add(TokenMnemonic, op)
+	for i, a := range args {
+		if i == 0 {
+			ts.text(" ")
+		} else {
+			ts.text(", ")
+		}
+		ts.addAll(a)
 	}
-
-	return rep + prefix + op
+	return ts
 }
 
-func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) string {
+func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) tokens {
 	switch a := arg.(type) {
 	case Reg:
-		return plan9Reg[a]
+		return tok(TokenRegister, plan9Reg[a])
 	case Rel:
 		if pc == 0 {
-			break
+			return tok(TokenAddress, arg.String())
 		}
 		// If the absolute address is the start of a symbol, use the name.
 		// Otherwise use the raw address, so that things like relative
@@ -111,9 +123,9 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 		// arithmetic to find f+10.
 		addr := pc + uint64(inst.Len) + uint64(a)
 		if s, base := symname(addr); s != "" && addr == base {
-			return fmt.Sprintf("%s(SB)", s)
+			return append(tok(TokenSymbol, s), Token{TokenText, "(SB)"})
 		}
-		return fmt.Sprintf("%#x", addr)
+		return tok(TokenAddress, fmt.Sprintf("%#x", addr))
 
 	case Imm:
 		if s, base := symname(uint64(a)); s != "" {
@@ -121,41 +133,51 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 			if uint64(a) != base {
 				suffix = fmt.Sprintf("%+d", uint64(a)-base)
 			}
-			return fmt.Sprintf("$%s%s(SB)", s, suffix)
+			ts := tok(TokenText, "$")
+			ts.addAll(symTokens(s, suffix))
+			ts.text("(SB)")
+			return ts
 		}
 		if inst.Mode == 32 {
-			return fmt.Sprintf("$%#x", uint32(a))
+			return tok(TokenImmediate, fmt.Sprintf("$%#x", uint32(a)))
 		}
 		if Imm(int32(a)) == a {
-			return fmt.Sprintf("$%#x", int64(a))
+			return tok(TokenImmediate, fmt.Sprintf("$%#x", int64(a)))
 		}
-		return fmt.Sprintf("$%#x", uint64(a))
+		return tok(TokenImmediate, fmt.Sprintf("$%#x", uint64(a)))
 	case Mem:
 		if s, disp := memArgToSymbol(a, pc, inst.Len, symname); s != "" {
 			suffix := ""
 			if disp != 0 {
 				suffix = fmt.Sprintf("%+d", disp)
 			}
-			return fmt.Sprintf("%s%s(SB)", s, suffix)
+			return append(symTokens(s, suffix), Token{TokenText, "(SB)"})
 		}
-		s := ""
+		var ts tokens
 		if a.Segment != 0 {
-			s += fmt.Sprintf("%s:", plan9Reg[a.Segment])
+			ts.add(TokenRegister, plan9Reg[a.Segment])
+			ts.text(":")
 		}
 		if a.Disp != 0 {
-			s += fmt.Sprintf("%#x", a.Disp)
+			ts.add(TokenImmediate, fmt.Sprintf("%#x", a.Disp))
 		} else {
-			s += "0"
+			ts.add(TokenImmediate, "0")
 		}
 		if a.Base != 0 {
-			s += fmt.Sprintf("(%s)", plan9Reg[a.Base])
+			ts.text("(")
+			ts.add(TokenRegister, plan9Reg[a.Base])
+			ts.text(")")
 		}
 		if a.Index != 0 && a.Scale != 0 {
-			s += fmt.Sprintf("(%s*%d)", plan9Reg[a.Index], a.Scale)
+			ts.text("(")
+			ts.add(TokenRegister, plan9Reg[a.Index])
+			ts.text("*")
+			ts.add(TokenImmediate, fmt.Sprint(a.Scale))
+			ts.text(")")
 		}
-		return s
+		return ts
 	}
-	return arg.String()
+	return tok(TokenText, arg.String())
 }
 
 func memArgToSymbol(a Mem, pc uint64, instrLen int, symname SymLookup) (string, int64) {
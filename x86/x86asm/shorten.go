@@ -0,0 +1,186 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "fmt"
+
+// A Suggestion describes a way to encode an instruction in fewer bytes
+// without changing what it does.
+type Suggestion struct {
+	Reason string // what makes the encoding longer than necessary
+	Saves  int    // number of bytes a shorter encoding saves
+}
+
+func (s Suggestion) String() string {
+	return fmt.Sprintf("%s (saves %d)", s.Reason, s.Saves)
+}
+
+// Suggest returns the ways in which the instruction at the start of src
+// could be encoded in fewer bytes in the given processor mode (16, 32,
+// or 64). It is meant for checking the output of assemblers and JIT
+// compilers, and recognizes:
+//
+//   - prefixes that have no effect, including REX prefixes with no
+//     bits that matter
+//   - 3-byte VEX prefixes where the 2-byte form can express the same
+//   - 32-bit (or 16-bit) displacements that fit in 8 bits, and
+//     8-bit zero displacements that can be omitted
+//   - 32-bit (or 16-bit) immediates that fit in a sign-extended 8-bit
+//     immediate, and ALU operations on the accumulator that do not use
+//     the short accumulator form
+//   - 64-bit immediate moves whose value fits in 32 bits
+//   - jumps with 32-bit (or 16-bit) offsets that fit in 8 bits
+//
+// Shortening a jump or any instruction before it changes the distance
+// to the jump target. Suggest assumes that only the instruction itself
+// changes length. NOP instructions, which are often lengthened
+// deliberately as padding, have no suggestions. Suggest does not
+// consult extensions and returns ErrUnrecognized for instructions
+// that only an Extension recognizes.
+func Suggest(src []byte, mode int) ([]Suggestion, error) {
+	inst, err := decodeTables(src, mode, false, nil)
+	if err == nil && inst.Op == 0 {
+		err = ErrUnrecognized
+	}
+	if err != nil {
+		return nil, err
+	}
+	if inst.Op == NOP {
+		return nil, nil
+	}
+	var sug []Suggestion
+	add := func(saves int, format string, args ...interface{}) {
+		sug = append(sug, Suggestion{fmt.Sprintf(format, args...), saves})
+	}
+
+	if inst.Prefix[0].IsVEX() {
+		// The decoder lists the payload bytes of a VEX prefix as
+		// prefixes too, so there is nothing more to check here.
+		if v, _ := VEXPrefix(inst); !v.Short && v.CanShort() {
+			add(1, "3-byte VEX prefix where the 2-byte form suffices")
+		}
+	} else {
+		for _, p := range inst.Prefix {
+			if p == 0 {
+				break
+			}
+			if (p&PrefixIgnored != 0 || p.IsREX() && p&PrefixImplicit == 0) && !deliberatePrefix(&inst, p) {
+				add(1, "redundant %v prefix", p)
+			}
+		}
+	}
+
+	e, err := layout(src, mode)
+	if err != nil || e.len != inst.Len {
+		// Decode and InstLen disagree about the layout only
+		// for unusual VEX encodings; make no further suggestions.
+		return sug, nil
+	}
+	sdisp := signedLE(src[e.len-e.imm-e.disp : e.len-e.imm])
+	simm := signedLE(src[e.len-e.imm : e.len])
+	fits8 := func(x int64) bool { return -128 <= x && x <= 127 }
+
+	if e.modrm >= 0 {
+		mod := src[e.modrm] >> 6
+		if mod == 2 && fits8(sdisp) {
+			add(e.disp-1, "%d-byte displacement %#x fits in 1 byte", e.disp, sdisp)
+		}
+		if mod == 1 && sdisp == 0 && !needsDisp(&inst) {
+			add(1, "zero displacement can be omitted")
+		}
+	}
+
+	if e.opMap == -1 {
+		op := src[e.op]
+		acc := e.modrm >= 0 && src[e.modrm]>>6 == 3 && isAccumulator(inst.Args[0])
+		switch {
+		case (op == 0x81 || op == 0x69 || op == 0x68) && e.imm > 1 && fits8(simm):
+			add(e.imm-1, "%d-byte immediate %#x fits in a sign-extended byte", e.imm, simm)
+		case (op == 0x80 || op == 0x81) && acc:
+			add(1, "%v on %v has a shorter accumulator form", inst.Op, inst.Args[0])
+		}
+		if op&0xF8 == 0xB8 && e.imm == 8 {
+			rexB := 0
+			if Prefix(src[e.op-1])&PrefixREXB != 0 {
+				rexB = 1
+			}
+			switch {
+			case uint64(simm) <= 0xFFFFFFFF:
+				add(inst.Len-(e.op-1+rexB+5), "64-bit immediate %#x fits in 32 bits, zero-extended", uint64(simm))
+			case simm == int64(int32(simm)):
+				add(3, "64-bit immediate %#x fits in 32 bits, sign-extended", simm)
+			}
+		}
+	}
+
+	// Jumps: E9 and 0F 8x with 2- or 4-byte offsets have 2-byte
+	// forms, EB and 7x, with 1-byte offsets.
+	if rel, ok := inst.Args[0].(Rel); ok && e.imm > 1 {
+		short := 0
+		switch {
+		case e.opMap == -1 && src[e.op] == 0xE9:
+			short = e.op + 2
+		case e.opMap == 1 && src[e.op]&0xF0 == 0x80:
+			short = e.op - 1 + 2
+		}
+		if short > 0 && fits8(int64(rel)+int64(inst.Len-short)) {
+			add(inst.Len-short, "%d-byte jump offset fits in 1 byte", e.imm)
+		}
+	}
+	return sug, nil
+}
+
+// signedLE returns the little-endian two's complement value in b.
+// deliberatePrefix reports whether p, which the processor otherwise
+// ignores, is one that code emits on purpose: NOTRACK (3E) on an
+// indirect JMP or CALL, which exempts it from CET indirect branch
+// tracking, and REP on RET, which some AMD processors predict better.
+func deliberatePrefix(inst *Inst, p Prefix) bool {
+	switch p & 0xFF {
+	case PrefixDS:
+		if inst.Op == JMP || inst.Op == CALL {
+			_, direct := inst.Args[0].(Rel)
+			return !direct
+		}
+	case PrefixREP:
+		return inst.Op == RET
+	}
+	return false
+}
+
+func signedLE(b []byte) int64 {
+	var x uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		x = x<<8 | uint64(b[i])
+	}
+	if n := uint(len(b)) * 8; n > 0 && n < 64 {
+		x = uint64(int64(x<<(64-n)) >> (64 - n))
+	}
+	return int64(x)
+}
+
+// needsDisp reports whether the memory operand of inst may have a base
+// register that cannot be encoded without a displacement. It errs on
+// the side of true: of the 16-bit forms, only [BP] needs one.
+func needsDisp(inst *Inst) bool {
+	for _, a := range inst.Args {
+		if m, ok := a.(Mem); ok {
+			switch m.Base {
+			case BP, EBP, RBP, R13W, R13L, R13:
+				return true
+			}
+			return false
+		}
+	}
+	return false
+}
+
+func isAccumulator(a Arg) bool {
+	switch a {
+	case AL, AX, EAX, RAX:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var suggestTests = []struct {
+	mode int
+	src  string
+	want string
+}{
+	{64, "4001d8", "[redundant REX prefix (saves 1)]"}, // rex add %ebx,%eax
+	{64, "4088e0", "[]"}, // mov %spl,%al
+	{64, "48ffd0", "[redundant REX.W prefix (saves 1)]"}, // rex.W call *%rax
+	{64, "3e8b00", "[redundant DS prefix (saves 1)]"},    // mov %ds:(%rax),%eax
+	{64, "648b00", "[]"},                                    // mov %fs:(%rax),%eax
+	{64, "3effe0", "[]"},                                    // notrack jmp *%rax
+	{64, "3eff10", "[]"},                                    // notrack call *(%rax)
+	{64, "3eeb00", "[redundant DS prefix (saves 1)]"},       // ds jmp .+0x2
+	{64, "f3c3", "[]"},                                      // repz ret
+	{64, "666601d8", "[redundant DATA16 prefix (saves 1)]"}, // data32 add %bx,%ax
+	{64, "c4e17e6f06", "[3-byte VEX prefix where the 2-byte form suffices (saves 1)]"}, // vmovdqu (%rsi),%ymm0
+	{64, "c4c17e6f06", "[]"}, // vmovdqu (%r14),%ymm0
+	{64, "8b8010000000", "[4-byte displacement 0x10 fits in 1 byte (saves 3)]"}, // mov 0x10(%rax),%eax
+	{64, "8b4000", "[zero displacement can be omitted (saves 1)]"},              // mov (%rax),%eax
+	{64, "8b4500", "[]"},   // mov (%rbp),%eax
+	{64, "418b4500", "[]"}, // mov (%r13),%eax
+	{64, "81c301000000", "[4-byte immediate 0x1 fits in a sign-extended byte (saves 3)]"},            // add $0x1,%ebx
+	{64, "81c3ffffffff", "[4-byte immediate -0x1 fits in a sign-extended byte (saves 3)]"},           // add $-0x1,%ebx
+	{64, "4881c000010000", "[ADD on RAX has a shorter accumulator form (saves 1)]"},                  // add $0x100,%rax
+	{64, "80c001", "[ADD on AL has a shorter accumulator form (saves 1)]"},                           // add $0x1,%al
+	{64, "6801000000", "[4-byte immediate 0x1 fits in a sign-extended byte (saves 3)]"},              // pushq $0x1
+	{64, "48b80100000000000000", "[64-bit immediate 0x1 fits in 32 bits, zero-extended (saves 5)]"},  // mov $0x1,%rax
+	{64, "49b80100000000000000", "[64-bit immediate 0x1 fits in 32 bits, zero-extended (saves 4)]"},  // mov $0x1,%r8
+	{64, "48b8ffffffffffffffff", "[64-bit immediate -0x1 fits in 32 bits, sign-extended (saves 3)]"}, // mov $-0x1,%rax
+	{64, "48b80000000001000000", "[]"},                                    // mov $0x100000000,%rax
+	{64, "e910000000", "[4-byte jump offset fits in 1 byte (saves 3)]"},   // jmp .+0x10
+	{64, "e97e000000", "[]"},                                              // jmp .+0x7e
+	{64, "0f847b000000", "[4-byte jump offset fits in 1 byte (saves 4)]"}, // je .+0x7b
+	{64, "0f847c000000", "[]"},                                            // je .+0x7c
+	{64, "e800000000", "[]"},                                              // call .+0
+	{64, "660f1f440000", "[]"},                                            // nopw 0x0(%rax,%rax,1)
+	{32, "81c001000000", "[4-byte immediate 0x1 fits in a sign-extended byte (saves 3)]"}, // add $0x1,%eax
+	{16, "81c00001", "[ADD on AX has a shorter accumulator form (saves 1)]"},              // add $0x100,%ax
+	{16, "8b870100", "[2-byte displacement 0x1 fits in 1 byte (saves 1)]"},                // mov 0x1(%bx),%ax
+}
+
+func TestSuggest(t *testing.T) {
+	for _, tt := range suggestTests {
+		var src []byte
+		fmt.Sscanf(tt.src, "%x", &src)
+		sug, err := Suggest(src, tt.mode)
+		if err != nil {
+			t.Errorf("Suggest(%s, %d): %v", tt.src, tt.mode, err)
+			continue
+		}
+		if got := fmt.Sprint(sug); got != tt.want {
+			t.Errorf("Suggest(%s, %d) = %s, want %s", tt.src, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	src := make([]byte, 15)
+	for i := 0; i < 100000; i++ {
+		r.Read(src)
+		for _, mode := range []int{16, 32, 64} {
+			sug, _ := Suggest(src, mode)
+			for _, s := range sug {
+				if s.Saves <= 0 {
+					t.Errorf("Suggest(% x, %d): %v saves no bytes", src, mode, s)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A TokenKind describes the role of a Token in formatted assembly text.
+// The kinds have the same meaning in all the syntaxes: for a given Inst,
+// GNU, Intel, MASM and Go syntax report the same registers, immediates,
+// addresses and symbols, even though they spell and order them differently.
+type TokenKind uint8
+
+const (
+	TokenText      TokenKind = iota // punctuation, spacing, size keywords and other literal text
+	TokenPrefix                     // instruction prefix, such as lock or rep
+	TokenMnemonic                   // instruction mnemonic, including any suffixes
+	TokenRegister                   // register name
+	TokenImmediate                  // immediate constant, displacement or scale
+	TokenAddress                    // code address, such as a branch target
+	TokenSymbol                     // symbol name
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case TokenText:
+		return "Text"
+	case TokenPrefix:
+		return "Prefix"
+	case TokenMnemonic:
+		return "Mnemonic"
+	case TokenRegister:
+		return "Register"
+	case TokenImmediate:
+		return "Immediate"
+	case TokenAddress:
+		return "Address"
+	case TokenSymbol:
+		return "Symbol"
+	}
+	return fmt.Sprintf("TokenKind(%d)", int(k))
+}
+
+// A Token is a piece of formatted assembly text.
+// Concatenating the Text of all the tokens emitted for an instruction
+// yields exactly the string returned by the corresponding syntax function.
+type Token struct {
+	Kind TokenKind
+	Text string
+}
+
+// GNUSyntaxTokens is like GNUSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func GNUSyntaxTokens(inst Inst, pc uint64, symname SymLookup, emit func(Token)) {
+	var ts tokens
+	appendGNUSyntax(nil, inst, pc, symname, &ts)
+	ts.emit(emit)
+}
+
+// IntelSyntaxTokens is like IntelSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func IntelSyntaxTokens(inst Inst, pc uint64, symname SymLookup, emit func(Token)) {
+	intelTokens(inst, pc, symname, false).emit(emit)
+}
+
+// MASMSyntaxTokens is like MASMSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func MASMSyntaxTokens(inst Inst, pc uint64, symname SymLookup, emit func(Token)) {
+	intelTokens(inst, pc, symname, true).emit(emit)
+}
+
+// GoSyntaxTokens is like GoSyntax but calls emit for each token of
+// the formatted instruction, in order, instead of returning a string.
+func GoSyntaxTokens(inst Inst, pc uint64, symname SymLookup, emit func(Token)) {
+	goTokens(inst, pc, symname).emit(emit)
+}
+
+// A tokens is formatted assembly text, as a sequence of tokens.
+// The Intel, MASM and Go printers format instructions as tokens and
+// return their concatenation. The GNU printer appends to a byte slice
+// to avoid allocating and, given a non-nil *tokens, marks the tokens
+// of the text as it appends it.
+type tokens []Token
+
+// add appends s as a token of the given kind.
+// Adjacent text tokens are merged. Like mark, add does
+// nothing if ts is nil.
+func (ts *tokens) add(kind TokenKind, s string) {
+	if ts == nil || s == "" {
+		return
+	}
+	if n := len(*ts); kind == TokenText && n > 0 && (*ts)[n-1].Kind == TokenText {
+		(*ts)[n-1].Text += s
+		return
+	}
+	*ts = append(*ts, Token{kind, s})
+}
+
+func (ts *tokens) text(s string) {
+	ts.add(TokenText, s)
+}
+
+func (ts *tokens) addAll(us tokens) {
+	for _, t := range us {
+		ts.add(t.Kind, t.Text)
+	}
+}
+
+// mark adds b[start:], just appended by a printer, as a token of the
+// given kind. It does nothing if ts is nil, without converting b.
+func (ts *tokens) mark(kind TokenKind, b []byte, start int) {
+	if ts != nil {
+		ts.add(kind, string(b[start:]))
+	}
+}
+
+func (ts tokens) String() string {
+	var b strings.Builder
+	for _, t := range ts {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+func (ts tokens) emit(emit func(Token)) {
+	for _, t := range ts {
+		emit(t)
+	}
+}
+
+// tok returns a single token of the given kind.
+func tok(kind TokenKind, s string) tokens {
+	return tokens{{kind, s}}
+}
+
+// symTokens returns the tokens of a reference to the symbol s
+// at offset suffix, such as "+8" or "".
+func symTokens(s, suffix string) tokens {
+	ts := tok(TokenSymbol, s)
+	ts.add(TokenImmediate, suffix)
+	return ts
+}
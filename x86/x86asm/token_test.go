@@ -0,0 +1,129 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func joinTokens(toks []Token) string {
+	var b strings.Builder
+	for _, t := range toks {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+func TestSyntaxTokens(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		if addr == 0x2000 {
+			return "runtime.foo", 0x2000
+		}
+		return "", 0
+	}
+	var tests = []struct {
+		enc    []byte
+		syntax string
+		want   []Token
+	}{
+		{[]byte{0xf0, 0x48, 0x01, 0x43, 0x08}, "gnu", []Token{
+			{TokenPrefix, "lock"}, {TokenText, " "}, {TokenMnemonic, "add"}, {TokenText, " "},
+			{TokenRegister, "%rax"}, {TokenText, ","}, {TokenImmediate, "0x8"}, {TokenText, "("},
+			{TokenRegister, "%rbx"}, {TokenText, ")"},
+		}},
+		{[]byte{0xf0, 0x48, 0x01, 0x43, 0x08}, "intel", []Token{
+			{TokenPrefix, "lock"}, {TokenText, " "}, {TokenMnemonic, "add"}, {TokenText, " qword ptr ["},
+			{TokenRegister, "rbx"}, {TokenText, "+"}, {TokenImmediate, "0x8"}, {TokenText, "], "},
+			{TokenRegister, "rax"},
+		}},
+		{[]byte{0xdd, 0xd9}, "masm", []Token{
+			{TokenMnemonic, "fstp"}, {TokenText, " "}, {TokenRegister, "st(1)"}, {TokenText, ", "}, {TokenRegister, "st(0)"},
+		}},
+		{[]byte{0xe8, 0xfc, 0x0f, 0x00, 0x00}, "masm", []Token{
+			{TokenMnemonic, "call"}, {TokenText, " "}, {TokenAddress, "2001h"},
+		}},
+		{[]byte{0xf3, 0xaa}, "plan9", []Token{
+			{TokenPrefix, "REP"}, {TokenText, "; "}, {TokenMnemonic, "STOSB"}, {TokenText, " "},
+			{TokenRegister, "AL"}, {TokenText, ", "}, {TokenRegister, "ES"}, {TokenText, ":"}, {TokenImmediate, "0"}, {TokenText, "("},
+			{TokenRegister, "DI"}, {TokenText, ")"},
+		}},
+		{[]byte{0xe8, 0xfb, 0x0f, 0x00, 0x00}, "plan9", []Token{
+			{TokenMnemonic, "CALL"}, {TokenText, " "}, {TokenSymbol, "runtime.foo"}, {TokenText, "(SB)"},
+		}},
+		{[]byte{0xe8, 0xfc, 0x0f, 0x00, 0x00}, "gnu", []Token{
+			{TokenMnemonic, "callq"}, {TokenText, " "}, {TokenAddress, "0x2001"},
+		}},
+		{[]byte{0x48, 0x8b, 0x05, 0xf9, 0x0f, 0x00, 0x00}, "gnu", []Token{
+			{TokenMnemonic, "mov"}, {TokenText, " "}, {TokenSymbol, "runtime.foo"}, {TokenText, ","},
+			{TokenRegister, "%rax"},
+		}},
+		{[]byte{0xdd, 0xd9}, "gnu", []Token{
+			{TokenMnemonic, "fstp"}, {TokenText, " "}, {TokenRegister, "%st(1)"},
+		}},
+	}
+	for _, tt := range tests {
+		inst, err := Decode(tt.enc, 64)
+		if err != nil {
+			t.Errorf("Decode(% x): %v", tt.enc, err)
+			continue
+		}
+		var toks []Token
+		emit := func(t Token) { toks = append(toks, t) }
+		switch tt.syntax {
+		case "gnu":
+			GNUSyntaxTokens(inst, 0x1000, symname, emit)
+		case "intel":
+			IntelSyntaxTokens(inst, 0x1000, symname, emit)
+		case "masm":
+			MASMSyntaxTokens(inst, 0x1000, symname, emit)
+		case "plan9":
+			GoSyntaxTokens(inst, 0x1000, symname, emit)
+		}
+		if !reflect.DeepEqual(toks, tt.want) {
+			t.Errorf("% x [%s] tokens = %v, want %v", tt.enc, tt.syntax, toks, tt.want)
+		}
+	}
+}
+
+// TestTokenKindsAgree checks that the syntaxes report the same
+// operand tokens for an instruction, even if in a different order.
+func TestTokenKindsAgree(t *testing.T) {
+	var tests = [][]byte{
+		{0x48, 0x01, 0x43, 0x08},                               // add [rbx+8], rax
+		{0x8b, 0x44, 0x8b, 0xf8},                               // mov eax, [rbx+rcx*4-8]
+		{0x48, 0x83, 0xc4, 0x10},                               // add rsp, 0x10
+		{0xe9, 0x10, 0x00, 0x00, 0x00},                         // jmp .+0x10
+		{0x66, 0x0f, 0x6f, 0x40, 0x10},                         // movdqa xmm0, [rax+0x10]
+		{0x64, 0x48, 0x8b, 0x04, 0x25, 0x28, 0x00, 0x00, 0x00}, // mov rax, fs:0x28
+	}
+	for _, enc := range tests {
+		inst, err := Decode(enc, 64)
+		if err != nil {
+			t.Errorf("Decode(% x): %v", enc, err)
+			continue
+		}
+		count := func(f func(Inst, uint64, SymLookup, func(Token))) map[TokenKind]int {
+			m := make(map[TokenKind]int)
+			f(inst, 0x1000, nil, func(t Token) {
+				if t.Kind != TokenText {
+					m[t.Kind]++
+				}
+			})
+			return m
+		}
+		gnu := count(GNUSyntaxTokens)
+		if intel := count(IntelSyntaxTokens); !reflect.DeepEqual(gnu, intel) {
+			t.Errorf("%v: GNU token kinds %v, Intel %v", inst, gnu, intel)
+		}
+		if masm := count(MASMSyntaxTokens); !reflect.DeepEqual(gnu, masm) {
+			t.Errorf("%v: GNU token kinds %v, MASM %v", inst, gnu, masm)
+		}
+		if plan9 := count(GoSyntaxTokens); !reflect.DeepEqual(gnu, plan9) {
+			t.Errorf("%v: GNU token kinds %v, Go %v", inst, gnu, plan9)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import "fmt"
+
+// A VEX holds the fields of a VEX prefix. The fields that the
+// encoding stores inverted (R, X, B and vvvv) are held uninverted.
+type VEX struct {
+	Short bool  // 2-byte form (C5) rather than 3-byte form (C4)
+	R     bool  // extension of the ModRM reg field
+	X     bool  // extension of the SIB index field
+	B     bool  // extension of the ModRM r/m or SIB base field
+	W     bool  // operand size or opcode extension
+	Map   uint8 // opcode map: 1 for 0F, 2 for 0F38, 3 for 0F3A
+	V     uint8 // additional register operand (vvvv)
+	L     bool  // 256-bit vector length
+	PP    uint8 // implied prefix: 0 for none, 1 for 66, 2 for F3, 3 for F2
+}
+
+// VEXPrefix returns the VEX prefix of inst, as recorded by Decode
+// in inst.Prefix. It reports whether inst has a VEX prefix.
+func VEXPrefix(inst Inst) (VEX, bool) {
+	p := inst.Prefix[0]
+	if !p.IsVEX() {
+		return VEX{}, false
+	}
+	b1 := byte(inst.Prefix[1])
+	v := VEX{R: b1&0x80 == 0}
+	b2 := b1 // the byte holding W, vvvv, L and pp
+	if p&0xFF == PrefixVEX2Bytes {
+		v.Short = true
+		v.Map = 1
+	} else {
+		v.X = b1&0x40 == 0
+		v.B = b1&0x20 == 0
+		v.Map = b1 & 0x1F
+		b2 = byte(inst.Prefix[2])
+		v.W = b2&0x80 != 0
+	}
+	v.V = ^b2 >> 3 & 0xF
+	v.L = b2&0x04 != 0
+	v.PP = b2 & 3
+	return v, true
+}
+
+// CanShort reports whether the 2-byte form can express v:
+// X, B and W are clear and the opcode map is 0F.
+func (v VEX) CanShort() bool {
+	return !v.X && !v.B && !v.W && v.Map == 1
+}
+
+// Encode returns the encoding of v, in the form v.Short selects.
+// Because VEXPrefix records the form, encoding the prefix of a decoded
+// instruction reproduces it byte for byte, keeping the instruction
+// length, as patching tools require. To use the shortest form instead,
+// set v.Short to v.CanShort() first.
+func (v VEX) Encode() ([]byte, error) {
+	if v.V > 15 || v.PP > 3 || v.Map > 31 {
+		return nil, fmt.Errorf("x86asm: invalid VEX prefix fields %+v", v)
+	}
+	b2 := ^v.V<<3&0x78 | v.PP
+	if v.L {
+		b2 |= 0x04
+	}
+	r := byte(0x80)
+	if v.R {
+		r = 0
+	}
+	if v.Short {
+		if !v.CanShort() {
+			return nil, fmt.Errorf("x86asm: VEX prefix %+v needs the 3-byte form", v)
+		}
+		return []byte{0xC5, r | b2}, nil
+	}
+	b1 := r | 0x60 | v.Map
+	if v.X {
+		b1 &^= 0x40
+	}
+	if v.B {
+		b1 &^= 0x20
+	}
+	if v.W {
+		b2 |= 0x80
+	}
+	return []byte{0xC4, b1, b2}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestVEXPrefix(t *testing.T) {
+	tests := []struct {
+		code  string
+		want  VEX
+		short string // encoding with Short set to CanShort
+	}{
+		{"\xc5\xf4\x58\xc2", VEX{Short: true, Map: 1, V: 1, L: true}, "\xc5\xf4"},          // vaddps ymm0, ymm1, ymm2
+		{"\xc4\xe1\x74\x58\xc2", VEX{Map: 1, V: 1, L: true}, "\xc5\xf4"},                   // vaddps ymm0, ymm1, ymm2
+		{"\xc4\xe2\x71\x00\xc2", VEX{Map: 2, V: 1, PP: 1}, "\xc4\xe2\x71"},                 // vpshufb xmm0, xmm1, xmm2
+		{"\xc4\x41\x30\x58\xc2", VEX{R: true, B: true, Map: 1, V: 9}, "\xc4\x41\x30"},      // vaddps xmm8, xmm9, xmm10
+		{"\xc4\xe3\xfd\x00\xc1\x1b", VEX{W: true, Map: 3, L: true, PP: 1}, "\xc4\xe3\xfd"}, // vpermq ymm0, ymm1, 0x1b
+	}
+	for _, tt := range tests {
+		inst, err := Decode([]byte(tt.code), 64)
+		if err != nil {
+			t.Fatalf("Decode(% x): %v", tt.code, err)
+		}
+		v, ok := VEXPrefix(inst)
+		if !ok || v != tt.want {
+			t.Errorf("VEXPrefix(%v) = %+v, %v, want %+v", inst, v, ok, tt.want)
+			continue
+		}
+		enc, err := v.Encode()
+		if n := len(enc); err != nil || n > len(tt.code) || string(enc) != tt.code[:n] {
+			t.Errorf("%+v.Encode() = % x, %v, want % x", v, enc, err, tt.code[:len(enc)])
+		}
+		v.Short = v.CanShort()
+		if enc, err := v.Encode(); err != nil || string(enc) != tt.short {
+			t.Errorf("%+v.Encode() = % x, %v, want % x", v, enc, err, tt.short)
+		}
+	}
+
+	v := VEX{Short: true, Map: 2}
+	if _, err := v.Encode(); err == nil {
+		t.Errorf("%+v.Encode() succeeded, want error", v)
+	}
+	if _, ok := VEXPrefix(Inst{Prefix: Prefixes{PrefixREX}}); ok {
+		t.Errorf("VEXPrefix of REX prefix succeeded")
+	}
+}
+
+// TestVEXRoundTrip checks that encoding the VEX prefix of decoded
+// instructions reproduces the original bytes.
+func TestVEXRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	src := make([]byte, 16)
+	n := 0
+	for i := 0; i < 100000; i++ {
+		r.Read(src)
+		src[0] = 0xC4 + byte(i&1)
+		inst, err := Decode(src, 64)
+		if err != nil || inst.Op == 0 {
+			continue
+		}
+		v, ok := VEXPrefix(inst)
+		if !ok {
+			continue
+		}
+		n++
+		enc, err := v.Encode()
+		if err != nil || !bytes.HasPrefix(src, enc) {
+			t.Fatalf("% x: %+v.Encode() = % x, %v", src[:inst.Len], v, enc, err)
+		}
+	}
+	if n == 0 {
+		t.Fatalf("no VEX instructions decoded")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// The json and csv output formats describe the instruction table in
+// a form meant for programs not written in Go and for comparing the
+// tables of different releases. The armmap and ppc64map generators
+// write the same formats, so that one reader handles all of them.
+//
+// The json format is an array with one object per instruction form,
+// with these fields:
+//
+//	op - opcode name, as in the Op constants of the disassembler
+//	syntax - assembly syntax from the manual
+//	encoding - encoding from the manual
+//	mask, value - the form matches an instruction word w when w&mask == value,
+//		as hexadecimal strings; omitted for x86, whose encodings are
+//		byte sequences rather than fixed-size words
+//	operands - operand names from the manual, in syntax order
+//	extension - ISA extension or level that introduced the instruction,
+//		if known (for x86, the CPUID feature)
+//	privileged - whether the instruction can only be executed
+//		by the operating system or hypervisor (for x86, rows tagged
+//		privileged in x86.csv and moves to and from control and
+//		debug registers)
+//
+// The csv format has a header line naming the same fields, in the same
+// order, followed by one line per instruction form. Operands are
+// separated by semicolons.
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// An exportInst is one instruction form in the json and csv formats.
+type exportInst struct {
+	Op         string   `json:"op"`
+	Syntax     string   `json:"syntax"`
+	Encoding   string   `json:"encoding"`
+	Mask       string   `json:"mask,omitempty"`
+	Value      string   `json:"value,omitempty"`
+	Operands   []string `json:"operands"`
+	Extension  string   `json:"extension,omitempty"`
+	Privileged bool     `json:"privileged"`
+}
+
+// exportTable converts the rows of x86.csv to exportInsts,
+// skipping pseudo-instructions, which the decoder ignores too.
+func exportTable(table [][]string) []exportInst {
+	var insts []exportInst
+	for _, row := range table {
+		text, encoding, cpuid, tags := row[0], row[1], row[4], row[5]
+		if strings.Contains(tags, "pseudo") {
+			continue
+		}
+		op, args := text, ""
+		if i := strings.Index(text, " "); i >= 0 {
+			op, args = text[:i], text[i+1:]
+		}
+		inst := exportInst{
+			Op:         op,
+			Syntax:     text,
+			Encoding:   encoding,
+			Operands:   []string{},
+			Extension:  cpuid,
+			Privileged: hasTag(tags, "privileged"),
+		}
+		if args != "" {
+			inst.Operands = strings.Split(args, ", ")
+		}
+		for _, arg := range inst.Operands {
+			if strings.HasPrefix(arg, "CR0-") || strings.HasPrefix(arg, "DR0-") {
+				inst.Privileged = true
+			}
+		}
+		insts = append(insts, inst)
+	}
+	return insts
+}
+
+// writeExport writes insts to w in the given format, json or csv.
+func writeExport(w io.Writer, format string, insts []exportInst) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "\t")
+		return enc.Encode(insts)
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"op", "syntax", "encoding", "mask", "value", "operands", "extension", "privileged"})
+		for _, inst := range insts {
+			cw.Write([]string{inst.Op, inst.Syntax, inst.Encoding, inst.Mask, inst.Value, strings.Join(inst.Operands, ";"), inst.Extension, strconv.FormatBool(inst.Privileged)})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
@@ -13,6 +13,10 @@
 //	text (default) - print decoding tree in text form
 //	decoder - print decoding tables for the x86asm package
 //	scanner - print scanning tables for x86scan package
+//	json - print the instruction table as JSON, for use outside Go
+//	csv - print the instruction table as CSV, for use outside Go
+//
+// The json and csv formats are described in export.go.
 package main
 
 import (
@@ -29,7 +33,7 @@ import (
 	"strings"
 )
 
-var format = flag.String("fmt", "text", "output format: text, decoder")
+var format = flag.String("fmt", "text", "output format: text, decoder, scanner, json, csv")
 
 var inputFile string
 
@@ -60,6 +64,15 @@ func main() {
 		print = printDecoder
 	case "scanner":
 		print = printScanner
+	case "json", "csv":
+		table, err := readTable(inputFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeExport(os.Stdout, *format, exportTable(table)); err != nil {
+			log.Fatal(err)
+		}
+		return
 	}
 
 	p, err := readCSV(flag.Arg(0))
@@ -75,6 +88,23 @@ func main() {
 // readCSV reads the CSV file and returns the corresponding Prog.
 // It may print details about problems to standard error using the log package.
 func readCSV(file string) (*Prog, error) {
+	table, err := readTable(file)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Prog{}
+	for _, row := range table {
+		add(p, row[0], row[1], row[2], row[3], row[4], row[5])
+	}
+
+	check(p)
+
+	return p, nil
+}
+
+// readTable reads the CSV file and returns its rows.
+func readTable(file string) ([][]string, error) {
 	// Read input.
 	// Skip leading blank and # comment lines.
 	f, err := os.Open(file)
@@ -107,15 +137,7 @@ func readCSV(file string) (*Prog, error) {
 	if len(table[0]) < 6 {
 		return nil, fmt.Errorf("csv too narrow: need at least six columns")
 	}
-
-	p := &Prog{}
-	for _, row := range table {
-		add(p, row[0], row[1], row[2], row[3], row[4], row[5])
-	}
-
-	check(p)
-
-	return p, nil
+	return table, nil
 }
 
 // A Prog is a single node in the tree representing the instruction format.
@@ -694,6 +716,108 @@ func printDecoder(p *Prog) {
 		fmt.Printf("\t%s: \"%s\",\n", op, op)
 	}
 	fmt.Printf("}\n")
+
+	printFeatures(opMap)
+	printPrivileged(opMap)
+}
+
+// printFeatures prints the table of CPUID features needed by each
+// decoded opcode, from the feature column of the CSV file.
+// An opcode needs no features if any of its forms needs none.
+func printFeatures(opMap map[string]bool) {
+	table, err := readTable(inputFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	need := make(map[string][]string)
+	free := make(map[string]bool)
+	for _, row := range table {
+		op, cpuid, tags := strings.Fields(row[0])[0], row[4], row[5]
+		if !opMap[op] || strings.Contains(tags, "pseudo") {
+			continue
+		}
+		var alts []string
+		switch {
+		case cpuid == "":
+			free[op] = true
+			continue
+		case strings.HasPrefix(cpuid, "Both "):
+			// Both X and Y flags
+			f := strings.Fields(cpuid)
+			alts = []string{"1<<Feature" + f[1] + " | 1<<Feature" + f[3]}
+		default:
+			// X or Y
+			for _, f := range strings.Split(cpuid, " or ") {
+				alts = append(alts, "1<<Feature"+f)
+			}
+		}
+	Alts:
+		for _, a := range alts {
+			for _, b := range need[op] {
+				if a == b {
+					continue Alts
+				}
+			}
+			need[op] = append(need[op], a)
+		}
+	}
+	var ops []string
+	for op := range need {
+		if !free[op] {
+			ops = append(ops, op)
+		}
+	}
+	sort.Strings(ops)
+
+	fmt.Printf("\n// opFeatures lists the processor features needed by each Op,\n")
+	fmt.Printf("// as alternatives: the Op is available on a processor that has\n")
+	fmt.Printf("// all the features in any one of them.\n")
+	fmt.Printf("var opFeatures = map[Op][]FeatureSet{\n")
+	for _, op := range ops {
+		fmt.Printf("\t%s: {%s},\n", op, strings.Join(need[op], ", "))
+	}
+	fmt.Printf("}\n")
+}
+
+// printPrivileged prints the set of decoded opcodes that the CSV file
+// tags as privileged.
+func printPrivileged(opMap map[string]bool) {
+	table, err := readTable(inputFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	priv := make(map[string]bool)
+	for _, row := range table {
+		op, tags := strings.Fields(row[0])[0], row[5]
+		if opMap[op] && !strings.Contains(tags, "pseudo") && hasTag(tags, "privileged") {
+			priv[op] = true
+		}
+	}
+	var ops []string
+	for op := range priv {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	fmt.Printf("\n// privilegedOps lists the Ops that raise #GP\n")
+	fmt.Printf("// unless executed at privilege level 0.\n")
+	fmt.Printf("// Moves to and from control and debug registers\n")
+	fmt.Printf("// are recognized by their operands.\n")
+	fmt.Printf("var privilegedOps = map[Op]bool{\n")
+	for _, op := range ops {
+		fmt.Printf("\t%s: true,\n", op)
+	}
+	fmt.Printf("}\n")
+}
+
+// hasTag reports whether the comma-separated tags include tag.
+func hasTag(tags, tag string) bool {
+	for _, t := range strings.Split(tags, ",") {
+		if t == tag {
+			return true
+		}
+	}
+	return false
 }
 
 // printScanner prints the decoding table for a scanner.